@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/client/clientset/versioned"
+	"github.com/lyft/flinkk8soperator/pkg/ctl"
+	"github.com/spf13/cobra"
+	ctrlRuntimeConfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+var (
+	ctlNamespace string
+	ctlWait      bool
+	ctlTimeout   time.Duration
+	ctlNonce     string
+	ctlClear     bool
+)
+
+// ctlCmd performs operational actions on a FlinkApplication by patching its spec
+var ctlCmd = &cobra.Command{
+	Use:   "ctl",
+	Short: "Performs actions such as savepoints, rollbacks and teardowns on a FlinkApplication",
+	Long: `Performs actions on a FlinkApplication by patching the fields of its spec that the operator acts on, instead of
+editing the resource by hand. With --wait, the command returns once the operator has completed the action.`,
+}
+
+func newCtlClient() (*ctl.Client, error) {
+	cfg, err := ctrlRuntimeConfig.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return ctl.NewClient(clientset, ctlNamespace), nil
+}
+
+func defaultNonce() string {
+	if ctlNonce != "" {
+		return ctlNonce
+	}
+	return time.Now().UTC().Format("20060102150405")
+}
+
+// Returns the RunE of an action, which waits for the returned condition if --wait is set
+func runCtlAction(action func(client *ctl.Client, name string, args []string) (ctl.Condition, error)) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		client, err := newCtlClient()
+		if err != nil {
+			return err
+		}
+
+		name := args[0]
+		condition, err := action(client, name, args[1:])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("flinkapplication %s/%s patched\n", ctlNamespace, name)
+		if !ctlWait {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), ctlTimeout)
+		defer cancel()
+		app, err := client.Wait(ctx, name, condition)
+		if app != nil {
+			_ = ctl.PrintStatus(os.Stdout, app)
+		}
+		return err
+	}
+}
+
+var ctlSavepointCmd = &cobra.Command{
+	Use:   "savepoint NAME",
+	Short: "Takes a savepoint of the running job by changing spec.savepointNonce",
+	Args:  cobra.ExactArgs(1),
+	RunE: runCtlAction(func(client *ctl.Client, name string, args []string) (ctl.Condition, error) {
+		return client.Savepoint(name, defaultNonce())
+	}),
+}
+
+var ctlRollbackCmd = &cobra.Command{
+	Use:   "rollback NAME",
+	Short: "Rolls back the deploy in progress by setting spec.forceRollback",
+	Long: `Rolls back the deploy in progress by setting spec.forceRollback. As forceRollback also rolls back any later deploy,
+it has to be cleared with --clear before the spec is updated again.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCtlAction(func(client *ctl.Client, name string, args []string) (ctl.Condition, error) {
+		return client.Rollback(name, ctlClear)
+	}),
+}
+
+var ctlRestartCmd = &cobra.Command{
+	Use:   "restart NAME",
+	Short: "Redeploys the application on a new cluster by changing spec.restartNonce",
+	Args:  cobra.ExactArgs(1),
+	RunE: runCtlAction(func(client *ctl.Client, name string, args []string) (ctl.Condition, error) {
+		return client.Restart(name, defaultNonce())
+	}),
+}
+
+var ctlPromoteCmd = &cobra.Command{
+	Use:   "promote NAME VERSION",
+	Short: "Keeps VERSION (blue or green) of a BlueGreen application and tears down the other one",
+	Args:  cobra.ExactArgs(2),
+	RunE: runCtlAction(func(client *ctl.Client, name string, args []string) (ctl.Condition, error) {
+		return client.Promote(name, v1beta1.FlinkApplicationVersion(args[0]))
+	}),
+}
+
+var ctlTeardownCmd = &cobra.Command{
+	Use:   "teardown NAME HASH",
+	Short: "Tears down the version of a BlueGreen application with the given hash by setting spec.tearDownVersionHash",
+	Args:  cobra.ExactArgs(2),
+	RunE: runCtlAction(func(client *ctl.Client, name string, args []string) (ctl.Condition, error) {
+		return client.Teardown(name, args[0])
+	}),
+}
+
+var ctlStatusCmd = &cobra.Command{
+	Use:   "status NAME",
+	Short: "Prints the status of the application and of each of its versions",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newCtlClient()
+		if err != nil {
+			return err
+		}
+		app, err := client.Get(args[0])
+		if err != nil {
+			return err
+		}
+		return ctl.PrintStatus(os.Stdout, app)
+	},
+}
+
+func init() {
+	ctlCmd.PersistentFlags().StringVarP(&ctlNamespace, "namespace", "n", "default", "namespace of the FlinkApplication")
+	ctlCmd.PersistentFlags().BoolVar(&ctlWait, "wait", false, "wait for the operator to complete the action")
+	ctlCmd.PersistentFlags().DurationVar(&ctlTimeout, "timeout", 10*time.Minute, "how long to wait with --wait")
+
+	ctlSavepointCmd.Flags().StringVar(&ctlNonce, "nonce", "", "value to set savepointNonce to; defaults to the current time")
+	ctlRestartCmd.Flags().StringVar(&ctlNonce, "nonce", "", "value to set restartNonce to; defaults to the current time")
+	ctlRollbackCmd.Flags().BoolVar(&ctlClear, "clear", false, "unset forceRollback after a rollback")
+
+	ctlCmd.AddCommand(ctlSavepointCmd, ctlRollbackCmd, ctlRestartCmd, ctlPromoteCmd, ctlTeardownCmd, ctlStatusCmd)
+	rootCmd.AddCommand(ctlCmd)
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta2"
+	"github.com/lyft/flinkk8soperator/pkg/controller/flink"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/json"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	renderFile     string
+	renderDiffFile string
+)
+
+// renderCmd prints the objects the operator would create for a FlinkApplication
+var renderCmd = &cobra.Command{
+	Use:   "render",
+	Short: "Prints the Kubernetes objects the operator would create for a FlinkApplication",
+	Long: `Prints the Deployments, Services and Ingress that the operator would create for the next deploy of the
+FlinkApplication in the given file, along with the rendered flink-conf and the hash of the deploy.
+
+With --diff, instead prints the fields that differ between the cluster of the FlinkApplication in the --diff file
+and that of the one in the -f file, which are the changes that cause the latter to be deployed as a new cluster.`,
+	Example: `  flinkoperator render -f app.yaml
+  flinkoperator render -f app.yaml --diff previous.yaml`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return initConfig(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app, err := readFlinkApplication(renderFile)
+		if err != nil {
+			return err
+		}
+
+		if renderDiffFile != "" {
+			oldApp, err := readFlinkApplication(renderDiffFile)
+			if err != nil {
+				return err
+			}
+			return printHashDiff(os.Stdout, oldApp, app)
+		}
+		return printRendered(os.Stdout, app)
+	},
+}
+
+func init() {
+	renderCmd.Flags().StringVarP(&renderFile, "filename", "f", "", "file containing the FlinkApplication to render")
+	renderCmd.Flags().StringVar(&renderDiffFile, "diff", "",
+		"file containing a previous version of the FlinkApplication to compare against")
+	_ = renderCmd.MarkFlagRequired("filename")
+	rootCmd.AddCommand(renderCmd)
+}
+
+// Reads a FlinkApplication of any served version from a YAML or JSON file
+func readFlinkApplication(path string) (*v1beta1.FlinkApplication, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := k8syaml.ToJSON(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", path)
+	}
+
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", path)
+	}
+
+	app := &v1beta1.FlinkApplication{}
+	switch typeMeta.APIVersion {
+	case v1beta2.SchemeGroupVersion.String():
+		v1beta2App := &v1beta2.FlinkApplication{}
+		if err := json.Unmarshal(raw, v1beta2App); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", path)
+		}
+		if err := v1beta2App.ConvertTo(app); err != nil {
+			return nil, err
+		}
+		app.TypeMeta = metav1.TypeMeta{
+			APIVersion: v1beta1.SchemeGroupVersion.String(),
+			Kind:       v1beta1.FlinkApplicationKind,
+		}
+	case v1beta1.SchemeGroupVersion.String():
+		if err := json.Unmarshal(raw, app); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", path)
+		}
+	default:
+		return nil, errors.Errorf("%s does not contain a FlinkApplication: unsupported apiVersion %q",
+			path, typeMeta.APIVersion)
+	}
+
+	if errs := flink.ValidateApplication(app); len(errs) > 0 {
+		return nil, errors.Errorf("invalid FlinkApplication in %s: %v", path, errs.ToAggregate())
+	}
+	return app, nil
+}
+
+func printRendered(out io.Writer, app *v1beta1.FlinkApplication) error {
+	rendered, err := flink.RenderApplication(app)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(out, "# hash: %s\n", rendered.Hash)
+	_, _ = fmt.Fprintf(out, "# jobmanager flink-conf:\n%s", commentLines(rendered.JobManagerFlinkConfig))
+	_, _ = fmt.Fprintf(out, "# taskmanager flink-conf:\n%s", commentLines(rendered.TaskManagerFlinkConfig))
+
+	objects := []interface{}{rendered.JobManagerDeployment}
+	if rendered.TaskManagerStatefulSet != nil {
+		objects = append(objects, rendered.TaskManagerStatefulSet)
+	} else {
+		objects = append(objects, rendered.TaskManagerDeployment)
+	}
+	objects = append(objects, rendered.JobManagerService, rendered.VersionedJobManagerService,
+		rendered.PodDisruptionBudget)
+	if rendered.JobManagerPodDisruptionBudget != nil {
+		objects = append(objects, rendered.JobManagerPodDisruptionBudget)
+	}
+	if rendered.JobManagerIngress != nil {
+		objects = append(objects, rendered.JobManagerIngress)
+	}
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(out, "---\n%s", data)
+	}
+	return nil
+}
+
+func printHashDiff(out io.Writer, oldApp *v1beta1.FlinkApplication, newApp *v1beta1.FlinkApplication) error {
+	changes, err := flink.DiffApplications(oldApp, newApp)
+	if err != nil {
+		return err
+	}
+
+	oldRendered, err := flink.RenderApplication(oldApp)
+	if err != nil {
+		return err
+	}
+	newRendered, err := flink.RenderApplication(newApp)
+	if err != nil {
+		return err
+	}
+
+	if oldRendered.Hash == newRendered.Hash {
+		_, _ = fmt.Fprintf(out, "hash unchanged: %s\n", newRendered.Hash)
+		return nil
+	}
+	_, _ = fmt.Fprintf(out, "hash changed: %s -> %s\n", oldRendered.Hash, newRendered.Hash)
+	_, _ = fmt.Fprint(out, flink.FormatHashChanges(changes))
+	return nil
+}
+
+func commentLines(s string) string {
+	var b strings.Builder
+	for _, line := range strings.Split(strings.TrimSpace(s), "\n") {
+		_, _ = fmt.Fprintf(&b, "#   %s\n", line)
+	}
+	return b.String()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -23,10 +24,13 @@ import (
 
 	"github.com/lyft/flinkk8soperator/pkg/controller"
 	controllerConfig "github.com/lyft/flinkk8soperator/pkg/controller/config"
+	"github.com/lyft/flinkk8soperator/pkg/controller/health"
+	"github.com/lyft/flinkk8soperator/pkg/webhook"
 	ctrlRuntimeConfig "sigs.k8s.io/controller-runtime/pkg/client/config"
 
 	"github.com/kubernetes-sigs/controller-runtime/pkg/runtime/signals"
 	apis "github.com/lyft/flinkk8soperator/pkg/apis/app"
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
 	"github.com/lyft/flytestdlib/profutils"
 	"github.com/lyft/flytestdlib/promutils"
 	"github.com/lyft/flytestdlib/promutils/labeled"
@@ -34,6 +38,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
+// Name of the ConfigMap that the replicas of the operator elect a leader through
+const leaderElectionID = "flinkk8soperator-leader"
+
 var (
 	cfgFile        string
 	configAccessor = viper.NewAccessor(config.Options{})
@@ -117,15 +124,22 @@ func executeRootCmd(controllerCfg *controllerConfig.Config) error {
 		logAndExit(errors.New("Invalid config: Metric prefix empty"))
 	}
 	operatorScope := promutils.NewScope(controllerCfg.MetricsPrefix)
+	leaderStatus := controller.NewLeaderStatus(operatorScope)
+	healthStatus := health.NewStatus(leaderStatus, controllerCfg.StuckReconcileTimeout.Duration)
 
 	go func() {
-		err := profutils.StartProfilingServerWithDefaultHandlers(ctx, controllerCfg.ProfilerPort.Port, nil)
+		handlers := map[string]http.Handler{
+			"/leader":  leaderStatus,
+			"/healthz": healthStatus.Liveness(),
+			"/readyz":  healthStatus.Readiness(),
+		}
+		err := profutils.StartProfilingServerWithDefaultHandlers(ctx, controllerCfg.ProfilerPort.Port, handlers)
 		if err != nil {
 			logger.Panicf(ctx, "Failed to Start profiling and metrics server. Error: %v", err)
 		}
 	}()
 
-	stopCh, err := operatorEntryPoint(ctx, operatorScope, controllerCfg)
+	stopCh, err := operatorEntryPoint(ctx, operatorScope, controllerCfg, leaderStatus, healthStatus)
 	if err != nil {
 		cancelNow()
 		return err
@@ -143,7 +157,8 @@ func executeRootCmd(controllerCfg *controllerConfig.Config) error {
 }
 
 func operatorEntryPoint(ctx context.Context, metricsScope promutils.Scope,
-	controllerCfg *controllerConfig.Config) (stopCh <-chan struct{}, err error) {
+	controllerCfg *controllerConfig.Config, leaderStatus *controller.LeaderStatus,
+	healthStatus *health.Status) (stopCh <-chan struct{}, err error) {
 
 	// Get a config to talk to the apiserver
 	cfg, err := ctrlRuntimeConfig.GetConfig()
@@ -152,20 +167,37 @@ func operatorEntryPoint(ctx context.Context, metricsScope promutils.Scope,
 	}
 
 	limitNameSpace := strings.TrimSpace(controllerCfg.LimitNamespace)
-	var mgr manager.Manager
+	options := manager.Options{
+		SyncPeriod: &controllerCfg.ResyncPeriod.Duration,
+		Port:       controllerCfg.WebhookPort,
+		CertDir:    controllerCfg.WebhookCertDir,
+	}
+
+	if controllerCfg.LeaderElection {
+		leaseDuration := controllerCfg.LeaseDuration.Duration
+		renewDeadline := controllerCfg.RenewDeadline.Duration
+		if leaseDuration > 0 && renewDeadline >= leaseDuration {
+			return nil, errors.Errorf("Invalid config: renewDeadline %v must be shorter than leaseDuration %v",
+				renewDeadline, leaseDuration)
+		}
+
+		options.LeaderElection = true
+		options.LeaderElectionID = leaderElectionID
+		options.LeaderElectionNamespace = controllerCfg.LeaseNamespace
+		if leaseDuration > 0 {
+			options.LeaseDuration = &leaseDuration
+		}
+		if renewDeadline > 0 {
+			options.RenewDeadline = &renewDeadline
+		}
+	}
 
-	if limitNameSpace == "" {
-		mgr, err = manager.New(cfg, manager.Options{
-			SyncPeriod: &controllerCfg.ResyncPeriod.Duration,
-		})
-	} else {
+	if limitNameSpace != "" {
 		namespaceList := strings.Split(limitNameSpace, ",")
-		mgr, err = manager.New(cfg, manager.Options{
-			NewCache:   cache.MultiNamespacedCacheBuilder(namespaceList),
-			SyncPeriod: &controllerCfg.ResyncPeriod.Duration,
-		})
+		options.NewCache = cache.MultiNamespacedCacheBuilder(namespaceList)
 	}
 
+	mgr, err := manager.New(cfg, options)
 	if err != nil {
 		return nil, err
 	}
@@ -177,16 +209,31 @@ func operatorEntryPoint(ctx context.Context, metricsScope promutils.Scope,
 		return nil, err
 	}
 
+	// Only started once this replica is the leader
+	if err := mgr.Add(leaderStatus); err != nil {
+		return nil, err
+	}
+
 	// Setup all Controllers
 	logger.Infof(ctx, "Adding controllers.")
-	if err := controller.AddToManager(ctx, mgr, controllerConfig.RuntimeConfig{
+	runtimeCfg := controllerConfig.RuntimeConfig{
 		MetricsScope: metricsScope,
-	}); err != nil {
+		Health:       healthStatus,
+	}
+	if err := controller.AddToManager(ctx, mgr, runtimeCfg); err != nil {
 		return nil, err
 	}
 
+	if controllerCfg.EnableWebhooks {
+		logger.Infof(ctx, "Adding webhooks.")
+		if err := webhook.AddToManager(ctx, mgr, runtimeCfg); err != nil {
+			return nil, err
+		}
+	}
+
 	// Start the Cmd
 	logger.Infof(ctx, "Starting the Cmd.")
 	stopCh = signals.SetupSignalHandler()
+	go healthStatus.WaitForCacheSync(ctx, mgr.GetCache(), stopCh, &v1beta1.FlinkApplication{})
 	return stopCh, mgr.Start(stopCh)
 }
@@ -0,0 +1,241 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// Most of the fields added since v1alpha1 cannot be represented in this version, so the full v1beta1 spec and status
+// are kept in an annotation when converting from v1beta1. When converting back, the fields that v1alpha1 does know
+// about are applied on top of them.
+type v1beta1Data struct {
+	Spec   v1beta1.FlinkApplicationSpec   `json:"spec"`
+	Status v1beta1.FlinkApplicationStatus `json:"status"`
+}
+
+// ConvertTo converts this FlinkApplication to the hub (v1beta1) version
+func (in *FlinkApplication) ConvertTo(dstRaw conversion.Hub) error {
+	out := dstRaw.(*v1beta1.FlinkApplication)
+
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = v1beta1.FlinkApplicationSpec{}
+	out.Status = v1beta1.FlinkApplicationStatus{}
+
+	if data, ok := out.Annotations[v1beta1.ConversionDataAnnotation]; ok {
+		delete(out.Annotations, v1beta1.ConversionDataAnnotation)
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+
+		var stored v1beta1Data
+		if err := json.Unmarshal([]byte(data), &stored); err != nil {
+			return err
+		}
+		out.Spec = stored.Spec
+		out.Status = stored.Status
+	}
+
+	convertSpecToV1beta1(&in.Spec, &out.Spec)
+	out.Status.SavepointTriggerID = in.Spec.SavepointInfo.TriggerID
+	convertStatusToV1beta1(&in.Status, &out.Status)
+
+	return nil
+}
+
+// ConvertFrom converts from the hub (v1beta1) version to this version
+func (in *FlinkApplication) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.FlinkApplication)
+
+	src.ObjectMeta.DeepCopyInto(&in.ObjectMeta)
+	convertSpecFromV1beta1(&src.Spec, &in.Spec)
+	in.Spec.SavepointInfo.TriggerID = src.Status.SavepointTriggerID
+	convertStatusFromV1beta1(&src.Status, &in.Status)
+
+	data, err := json.Marshal(v1beta1Data{Spec: src.Spec, Status: src.Status})
+	if err != nil {
+		return err
+	}
+
+	if in.Annotations == nil {
+		in.Annotations = map[string]string{}
+	}
+	in.Annotations[v1beta1.ConversionDataAnnotation] = string(data)
+
+	return nil
+}
+
+func convertSpecToV1beta1(in *FlinkApplicationSpec, out *v1beta1.FlinkApplicationSpec) {
+	out.Image = in.Image
+	out.ImagePullPolicy = in.ImagePullPolicy
+	out.ImagePullSecrets = in.ImagePullSecrets
+	out.FlinkConfig = v1beta1.FlinkConfig(in.FlinkConfig)
+	out.FlinkVersion = in.FlinkVersion
+	out.TaskManagerConfig.Resources = in.TaskManagerConfig.Resources
+	out.TaskManagerConfig.EnvConfig = v1beta1.EnvironmentConfig(in.TaskManagerConfig.EnvConfig)
+	out.TaskManagerConfig.TaskSlots = in.TaskManagerConfig.TaskSlots
+	out.TaskManagerConfig.OffHeapMemoryFraction = in.TaskManagerConfig.OffHeapMemoryFraction
+	out.TaskManagerConfig.NodeSelector = in.TaskManagerConfig.NodeSelector
+	out.JobManagerConfig.Resources = in.JobManagerConfig.Resources
+	out.JobManagerConfig.EnvConfig = v1beta1.EnvironmentConfig(in.JobManagerConfig.EnvConfig)
+	out.JobManagerConfig.Replicas = in.JobManagerConfig.Replicas
+	out.JobManagerConfig.OffHeapMemoryFraction = in.JobManagerConfig.OffHeapMemoryFraction
+	out.JobManagerConfig.NodeSelector = in.JobManagerConfig.NodeSelector
+	out.JarName = in.JarName
+	out.Parallelism = in.Parallelism
+	out.EntryClass = in.EntryClass
+	out.ProgramArgs = in.ProgramArgs
+	out.SavepointInfo.SavepointLocation = in.SavepointInfo.SavepointLocation
+	out.DeploymentMode = v1beta1.DeploymentMode(in.DeploymentMode)
+	out.RPCPort = in.RPCPort
+	out.BlobPort = in.BlobPort
+	out.QueryPort = in.QueryPort
+	out.UIPort = in.UIPort
+	out.MetricsQueryPort = in.MetricsQueryPort
+	out.Volumes = in.Volumes
+	out.VolumeMounts = in.VolumeMounts
+	out.RestartNonce = in.RestartNonce
+	out.DeleteMode = v1beta1.DeleteMode(in.DeleteMode)
+	out.AllowNonRestoredState = in.AllowNonRestoredState
+	out.ForceRollback = in.ForceRollback
+}
+
+func convertSpecFromV1beta1(in *v1beta1.FlinkApplicationSpec, out *FlinkApplicationSpec) {
+	out.Image = in.Image
+	out.ImagePullPolicy = in.ImagePullPolicy
+	out.ImagePullSecrets = in.ImagePullSecrets
+	out.FlinkConfig = FlinkConfig(in.FlinkConfig)
+	out.FlinkVersion = in.FlinkVersion
+	out.TaskManagerConfig = TaskManagerConfig{
+		Resources:             in.TaskManagerConfig.Resources,
+		EnvConfig:             EnvironmentConfig(in.TaskManagerConfig.EnvConfig),
+		TaskSlots:             in.TaskManagerConfig.TaskSlots,
+		OffHeapMemoryFraction: in.TaskManagerConfig.OffHeapMemoryFraction,
+		NodeSelector:          in.TaskManagerConfig.NodeSelector,
+	}
+	out.JobManagerConfig = JobManagerConfig{
+		Resources:             in.JobManagerConfig.Resources,
+		EnvConfig:             EnvironmentConfig(in.JobManagerConfig.EnvConfig),
+		Replicas:              in.JobManagerConfig.Replicas,
+		OffHeapMemoryFraction: in.JobManagerConfig.OffHeapMemoryFraction,
+		NodeSelector:          in.JobManagerConfig.NodeSelector,
+	}
+	out.JarName = in.JarName
+	out.Parallelism = in.Parallelism
+	out.EntryClass = in.EntryClass
+	out.ProgramArgs = in.ProgramArgs
+	out.SavepointInfo = SavepointInfo{SavepointLocation: in.SavepointInfo.SavepointLocation}
+	out.DeploymentMode = DeploymentMode(in.DeploymentMode)
+	out.RPCPort = in.RPCPort
+	out.BlobPort = in.BlobPort
+	out.QueryPort = in.QueryPort
+	out.UIPort = in.UIPort
+	out.MetricsQueryPort = in.MetricsQueryPort
+	out.Volumes = in.Volumes
+	out.VolumeMounts = in.VolumeMounts
+	out.RestartNonce = in.RestartNonce
+	out.DeleteMode = DeleteMode(in.DeleteMode)
+	out.AllowNonRestoredState = in.AllowNonRestoredState
+	out.ForceRollback = in.ForceRollback
+}
+
+func convertStatusToV1beta1(in *FlinkApplicationStatus, out *v1beta1.FlinkApplicationStatus) {
+	out.Phase = v1beta1.FlinkApplicationPhase(in.Phase)
+	out.StartedAt = in.StartedAt
+	out.LastUpdatedAt = in.LastUpdatedAt
+	out.Reason = in.Reason
+
+	out.ClusterStatus.Health = v1beta1.HealthStatus(in.ClusterStatus.Health)
+	out.ClusterStatus.NumberOfTaskManagers = in.ClusterStatus.NumberOfTaskManagers
+	out.ClusterStatus.HealthyTaskManagers = in.ClusterStatus.HealthyTaskManagers
+	out.ClusterStatus.NumberOfTaskSlots = in.ClusterStatus.NumberOfTaskSlots
+	out.ClusterStatus.AvailableTaskSlots = in.ClusterStatus.AvailableTaskSlots
+
+	out.JobStatus.JobID = in.JobStatus.JobID
+	out.JobStatus.Health = v1beta1.HealthStatus(in.JobStatus.Health)
+	out.JobStatus.State = v1beta1.JobState(in.JobStatus.State)
+	out.JobStatus.JarName = in.JobStatus.JarName
+	out.JobStatus.Parallelism = in.JobStatus.Parallelism
+	out.JobStatus.EntryClass = in.JobStatus.EntryClass
+	out.JobStatus.ProgramArgs = in.JobStatus.ProgramArgs
+	out.JobStatus.AllowNonRestoredState = in.JobStatus.AllowNonRestoredState
+	out.JobStatus.StartTime = in.JobStatus.StartTime
+	out.JobStatus.JobRestartCount = in.JobStatus.JobRestartCount
+	out.JobStatus.CompletedCheckpointCount = in.JobStatus.CompletedCheckpointCount
+	out.JobStatus.FailedCheckpointCount = in.JobStatus.FailedCheckpointCount
+	out.JobStatus.LastCheckpointTime = in.JobStatus.LastCheckpointTime
+	out.JobStatus.RestorePath = in.JobStatus.RestorePath
+	out.JobStatus.RestoreTime = in.JobStatus.RestoreTime
+	out.JobStatus.LastFailingTime = in.JobStatus.LastFailingTime
+
+	out.FailedDeployHash = in.FailedDeployHash
+	out.RollbackHash = in.RollbackHash
+	out.DeployHash = in.DeployHash
+	out.RetryCount = in.RetryCount
+
+	// v1alpha1 cannot distinguish between a missing and an empty error
+	if in.LastSeenError == (FlinkApplicationError{}) {
+		if out.LastSeenError != nil && *out.LastSeenError != (v1beta1.FlinkApplicationError{}) {
+			out.LastSeenError = nil
+		}
+	} else {
+		out.LastSeenError = &v1beta1.FlinkApplicationError{
+			AppError:            in.LastSeenError.AppError,
+			Method:              v1beta1.FlinkMethod(in.LastSeenError.Method),
+			ErrorCode:           in.LastSeenError.ErrorCode,
+			IsRetryable:         in.LastSeenError.IsRetryable,
+			IsFailFast:          in.LastSeenError.IsFailFast,
+			MaxRetries:          in.LastSeenError.MaxRetries,
+			LastErrorUpdateTime: in.LastSeenError.LastErrorUpdateTime,
+		}
+	}
+}
+
+func convertStatusFromV1beta1(in *v1beta1.FlinkApplicationStatus, out *FlinkApplicationStatus) {
+	out.Phase = FlinkApplicationPhase(in.Phase)
+	out.StartedAt = in.StartedAt
+	out.LastUpdatedAt = in.LastUpdatedAt
+	out.Reason = in.Reason
+	out.ClusterStatus = FlinkClusterStatus{
+		Health:               HealthStatus(in.ClusterStatus.Health),
+		NumberOfTaskManagers: in.ClusterStatus.NumberOfTaskManagers,
+		HealthyTaskManagers:  in.ClusterStatus.HealthyTaskManagers,
+		NumberOfTaskSlots:    in.ClusterStatus.NumberOfTaskSlots,
+		AvailableTaskSlots:   in.ClusterStatus.AvailableTaskSlots,
+	}
+	out.JobStatus = FlinkJobStatus{
+		JobID:                    in.JobStatus.JobID,
+		Health:                   HealthStatus(in.JobStatus.Health),
+		State:                    JobState(in.JobStatus.State),
+		JarName:                  in.JobStatus.JarName,
+		Parallelism:              in.JobStatus.Parallelism,
+		EntryClass:               in.JobStatus.EntryClass,
+		ProgramArgs:              in.JobStatus.ProgramArgs,
+		AllowNonRestoredState:    in.JobStatus.AllowNonRestoredState,
+		StartTime:                in.JobStatus.StartTime,
+		JobRestartCount:          in.JobStatus.JobRestartCount,
+		CompletedCheckpointCount: in.JobStatus.CompletedCheckpointCount,
+		FailedCheckpointCount:    in.JobStatus.FailedCheckpointCount,
+		LastCheckpointTime:       in.JobStatus.LastCheckpointTime,
+		RestorePath:              in.JobStatus.RestorePath,
+		RestoreTime:              in.JobStatus.RestoreTime,
+		LastFailingTime:          in.JobStatus.LastFailingTime,
+	}
+	out.FailedDeployHash = in.FailedDeployHash
+	out.RollbackHash = in.RollbackHash
+	out.DeployHash = in.DeployHash
+	out.RetryCount = in.RetryCount
+	out.LastSeenError = FlinkApplicationError{}
+	if in.LastSeenError != nil {
+		out.LastSeenError = FlinkApplicationError{
+			AppError:            in.LastSeenError.AppError,
+			Method:              FlinkMethod(in.LastSeenError.Method),
+			ErrorCode:           in.LastSeenError.ErrorCode,
+			IsRetryable:         in.LastSeenError.IsRetryable,
+			IsFailFast:          in.LastSeenError.IsFailFast,
+			MaxRetries:          in.LastSeenError.MaxRetries,
+			LastErrorUpdateTime: in.LastSeenError.LastErrorUpdateTime,
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+func getV1alpha1App() *FlinkApplication {
+	slots := int32(4)
+	now := metav1.Unix(1568000000, 0)
+
+	return &FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-name",
+			Namespace: "ns",
+		},
+		Spec: FlinkApplicationSpec{
+			Image:        "flink-image",
+			FlinkConfig:  FlinkConfig{"akka.timeout": "5s", "state.backend.fs.threshold": 1024},
+			FlinkVersion: "1.8",
+			TaskManagerConfig: TaskManagerConfig{
+				Resources: &apiv1.ResourceRequirements{
+					Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("2Gi")},
+				},
+				TaskSlots: &slots,
+			},
+			JarName:     "job.jar",
+			Parallelism: 8,
+			SavepointInfo: SavepointInfo{
+				SavepointLocation: "s3://savepoints/1",
+				TriggerID:         "trigger",
+			},
+			DeploymentMode: DeploymentModeDual,
+		},
+		Status: FlinkApplicationStatus{
+			Phase:     FlinkApplicationRunning,
+			StartedAt: &now,
+			JobStatus: FlinkJobStatus{
+				JobID:     "job-id",
+				State:     Running,
+				StartTime: &now,
+			},
+			DeployHash: "abcd1234",
+			LastSeenError: FlinkApplicationError{
+				AppError: "error",
+				Method:   SubmitJob,
+			},
+		},
+	}
+}
+
+func assertSemanticEqual(t *testing.T, expected interface{}, actual interface{}) {
+	assert.True(t, apiequality.Semantic.DeepEqual(expected, actual), diff.ObjectReflectDiff(expected, actual))
+}
+
+func TestV1alpha1RoundTrip(t *testing.T) {
+	app := getV1alpha1App()
+
+	hub := &v1beta1.FlinkApplication{}
+	assert.Nil(t, app.ConvertTo(hub))
+	assert.Equal(t, "trigger", hub.Status.SavepointTriggerID)
+	assert.Equal(t, "error", hub.Status.LastSeenError.AppError)
+
+	result := &FlinkApplication{}
+	assert.Nil(t, result.ConvertFrom(hub))
+	assert.Contains(t, result.Annotations, v1beta1.ConversionDataAnnotation)
+	delete(result.Annotations, v1beta1.ConversionDataAnnotation)
+	assertSemanticEqual(t, app, result)
+}
+
+func TestV1beta1RoundTrip(t *testing.T) {
+	app := getV1alpha1App()
+	hub := &v1beta1.FlinkApplication{}
+	assert.Nil(t, app.ConvertTo(hub))
+
+	// set the fields that v1alpha1 does not know about
+	hub.Spec.ServiceAccountName = "flink"
+	hub.Spec.SavepointPath = "s3://savepoints/2"
+	hub.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	hub.Spec.TaskManagerConfig.Tolerations = []apiv1.Toleration{{Key: "dedicated", Value: "flink"}}
+	hub.Status.ClusterStatus.ClusterOverviewURL = "https://flink/app-name"
+	hub.Status.JobStatus.RunningTasks = 8
+	hub.Status.VersionStatuses = []v1beta1.FlinkApplicationVersionStatus{
+		{Version: v1beta1.BlueFlinkApplication, VersionHash: "abcd1234"},
+	}
+	hub.Status.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+
+	alpha := &FlinkApplication{}
+	assert.Nil(t, alpha.ConvertFrom(hub))
+
+	result := &v1beta1.FlinkApplication{}
+	assert.Nil(t, alpha.ConvertTo(result))
+	assert.NotContains(t, result.Annotations, v1beta1.ConversionDataAnnotation)
+	assertSemanticEqual(t, hub, result)
+}
+
+func TestChangesToV1alpha1FieldsAreKept(t *testing.T) {
+	hub := &v1beta1.FlinkApplication{}
+	assert.Nil(t, getV1alpha1App().ConvertTo(hub))
+	hub.Spec.ServiceAccountName = "flink"
+
+	alpha := &FlinkApplication{}
+	assert.Nil(t, alpha.ConvertFrom(hub))
+	alpha.Spec.Parallelism = 16
+	alpha.Spec.TaskManagerConfig.TaskSlots = nil
+	alpha.Status.LastSeenError = FlinkApplicationError{}
+
+	result := &v1beta1.FlinkApplication{}
+	assert.Nil(t, alpha.ConvertTo(result))
+	assert.Equal(t, int32(16), result.Spec.Parallelism)
+	assert.Nil(t, result.Spec.TaskManagerConfig.TaskSlots)
+	assert.Nil(t, result.Status.LastSeenError)
+	assert.Equal(t, "flink", result.Spec.ServiceAccountName)
+}
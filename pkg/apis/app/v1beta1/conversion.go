@@ -0,0 +1,8 @@
+package v1beta1
+
+// Annotation used by the other versions of the API to keep the v1beta1 fields that they cannot represent, so that
+// converting an object to another version and back does not lose information
+const ConversionDataAnnotation = "flink.k8s.io/conversion-data"
+
+// Hub marks v1beta1, the storage version, as the version that all other versions are converted to and from
+func (*FlinkApplication) Hub() {}
@@ -0,0 +1,165 @@
+package v1beta1
+
+import (
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	DefaultJobManagerReplicas = 1
+	DefaultTaskManagerSlots   = 16
+	DefaultRPCPort            = 6123
+	DefaultQueryPort          = 6124
+	DefaultBlobPort           = 6125
+	DefaultUIPort             = 8081
+	DefaultMetricsQueryPort   = 50101
+
+	DefaultAutoscalerTargetUtilization = 0.7
+	DefaultAutoscalerCooldown          = 10 * time.Minute
+
+	DefaultSavepointRetainCount = 10
+
+	DefaultPromotionSoakDuration            = 10 * time.Minute
+	DefaultPromotionMaxJobRestarts          = 0
+	DefaultPromotionMinCompletedCheckpoints = 1
+	DefaultPromotionMaxFailedCheckpoints    = 0
+
+	DefaultAutoRollbackWindow                          = 15 * time.Minute
+	DefaultAutoRollbackMaxJobRestarts                  = 3
+	DefaultAutoRollbackMaxConsecutiveFailedCheckpoints = 3
+	DefaultAutoRollbackTaskManagerHeartbeatTimeout     = 2 * time.Minute
+
+	DefaultBoundedJobTTLAfterFinished = 10 * time.Minute
+)
+
+var DefaultAutoscalerMetrics = []AutoscalerMetric{AutoscalerMetricBusyTime}
+
+var DefaultJobManagerResources = apiv1.ResourceRequirements{
+	Requests: apiv1.ResourceList{
+		apiv1.ResourceCPU:    resource.MustParse("4"),
+		apiv1.ResourceMemory: resource.MustParse("3072Mi"),
+	},
+	Limits: apiv1.ResourceList{
+		apiv1.ResourceCPU:    resource.MustParse("4"),
+		apiv1.ResourceMemory: resource.MustParse("3072Mi"),
+	},
+}
+
+var DefaultTaskManagerResources = apiv1.ResourceRequirements{
+	Requests: apiv1.ResourceList{
+		apiv1.ResourceCPU:    resource.MustParse("2"),
+		apiv1.ResourceMemory: resource.MustParse("1024Mi"),
+	},
+	Limits: apiv1.ResourceList{
+		apiv1.ResourceCPU:    resource.MustParse("2"),
+		apiv1.ResourceMemory: resource.MustParse("1024Mi"),
+	},
+}
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+// SetDefaults_FlinkApplication fills in the values that the operator otherwise assumes for unset fields, so that
+// they are visible on the stored resource. Only fields whose unset value is treated exactly like the default may be
+// set here, as anything else would change the hash of existing applications.
+func SetDefaults_FlinkApplication(obj *FlinkApplication) { //nolint:golint
+	spec := &obj.Spec
+
+	if spec.DeploymentMode == "" {
+		spec.DeploymentMode = DeploymentModeDual
+	}
+	if spec.ExecutionMode == "" {
+		spec.ExecutionMode = ExecutionModeSession
+	}
+
+	if spec.JobManagerConfig.Resources == nil {
+		spec.JobManagerConfig.Resources = DefaultJobManagerResources.DeepCopy()
+	}
+	if spec.JobManagerConfig.Replicas == nil {
+		spec.JobManagerConfig.Replicas = int32Ptr(DefaultJobManagerReplicas)
+	}
+
+	if spec.TaskManagerConfig.Resources == nil {
+		spec.TaskManagerConfig.Resources = DefaultTaskManagerResources.DeepCopy()
+	}
+	if spec.TaskManagerConfig.TaskSlots == nil {
+		spec.TaskManagerConfig.TaskSlots = int32Ptr(DefaultTaskManagerSlots)
+	}
+
+	if spec.RPCPort == nil {
+		spec.RPCPort = int32Ptr(DefaultRPCPort)
+	}
+	if spec.QueryPort == nil {
+		spec.QueryPort = int32Ptr(DefaultQueryPort)
+	}
+	if spec.BlobPort == nil {
+		spec.BlobPort = int32Ptr(DefaultBlobPort)
+	}
+	if spec.UIPort == nil {
+		spec.UIPort = int32Ptr(DefaultUIPort)
+	}
+	if spec.MetricsQueryPort == nil {
+		spec.MetricsQueryPort = int32Ptr(DefaultMetricsQueryPort)
+	}
+
+	if autoscaler := spec.Autoscaler; autoscaler != nil {
+		if autoscaler.TargetUtilization == nil {
+			target := DefaultAutoscalerTargetUtilization
+			autoscaler.TargetUtilization = &target
+		}
+		if len(autoscaler.Metrics) == 0 {
+			autoscaler.Metrics = append([]AutoscalerMetric{}, DefaultAutoscalerMetrics...)
+		}
+		if autoscaler.Cooldown == nil {
+			autoscaler.Cooldown = &metav1.Duration{Duration: DefaultAutoscalerCooldown}
+		}
+	}
+
+	if schedule := spec.SavepointSchedule; schedule != nil && schedule.RetainCount == nil {
+		schedule.RetainCount = int32Ptr(DefaultSavepointRetainCount)
+	}
+
+	if spec.BlueGreen != nil && spec.BlueGreen.PromotionPolicy != nil {
+		policy := spec.BlueGreen.PromotionPolicy
+		if policy.SoakDuration == nil {
+			policy.SoakDuration = &metav1.Duration{Duration: DefaultPromotionSoakDuration}
+		}
+		if policy.MaxJobRestarts == nil {
+			policy.MaxJobRestarts = int32Ptr(DefaultPromotionMaxJobRestarts)
+		}
+		if policy.MinCompletedCheckpoints == nil {
+			policy.MinCompletedCheckpoints = int32Ptr(DefaultPromotionMinCompletedCheckpoints)
+		}
+		if policy.MaxFailedCheckpoints == nil {
+			policy.MaxFailedCheckpoints = int32Ptr(DefaultPromotionMaxFailedCheckpoints)
+		}
+	}
+
+	if policy := spec.AutoRollback; policy != nil {
+		if policy.Window == nil {
+			policy.Window = &metav1.Duration{Duration: DefaultAutoRollbackWindow}
+		}
+		if policy.MaxJobRestarts == nil {
+			policy.MaxJobRestarts = int32Ptr(DefaultAutoRollbackMaxJobRestarts)
+		}
+		if policy.MaxConsecutiveFailedCheckpoints == nil {
+			policy.MaxConsecutiveFailedCheckpoints = int32Ptr(DefaultAutoRollbackMaxConsecutiveFailedCheckpoints)
+		}
+		if policy.TaskManagerHeartbeatTimeout == nil {
+			policy.TaskManagerHeartbeatTimeout = &metav1.Duration{Duration: DefaultAutoRollbackTaskManagerHeartbeatTimeout}
+		}
+	}
+
+	if config := spec.BoundedJob; config != nil && config.TTLAfterFinished == nil {
+		config.TTLAfterFinished = &metav1.Duration{Duration: DefaultBoundedJobTTLAfterFinished}
+	}
+}
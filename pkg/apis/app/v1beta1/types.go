@@ -6,6 +6,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -38,27 +39,41 @@ type FlinkApplicationSpec struct {
 	TaskManagerConfig  TaskManagerConfig            `json:"taskManagerConfig,omitempty"`
 	JobManagerConfig   JobManagerConfig             `json:"jobManagerConfig,omitempty"`
 	JarName            string                       `json:"jarName"`
+	JarURI             string                       `json:"jarURI,omitempty"`
+	JarChecksum        string                       `json:"jarChecksum,omitempty"`
 	Parallelism        int32                        `json:"parallelism"`
 	EntryClass         string                       `json:"entryClass,omitempty"`
 	ProgramArgs        string                       `json:"programArgs,omitempty"`
 	// Deprecated: use SavepointPath instead
-	SavepointInfo                  SavepointInfo       `json:"savepointInfo,omitempty"`
-	SavepointPath                  string              `json:"savepointPath,omitempty"`
-	SavepointDisabled              bool                `json:"savepointDisabled"`
-	DeploymentMode                 DeploymentMode      `json:"deploymentMode,omitempty"`
-	RPCPort                        *int32              `json:"rpcPort,omitempty"`
-	BlobPort                       *int32              `json:"blobPort,omitempty"`
-	QueryPort                      *int32              `json:"queryPort,omitempty"`
-	UIPort                         *int32              `json:"uiPort,omitempty"`
-	MetricsQueryPort               *int32              `json:"metricsQueryPort,omitempty"`
-	Volumes                        []apiv1.Volume      `json:"volumes,omitempty"`
-	VolumeMounts                   []apiv1.VolumeMount `json:"volumeMounts,omitempty"`
-	RestartNonce                   string              `json:"restartNonce"`
-	DeleteMode                     DeleteMode          `json:"deleteMode,omitempty"`
-	AllowNonRestoredState          bool                `json:"allowNonRestoredState,omitempty"`
-	ForceRollback                  bool                `json:"forceRollback"`
-	MaxCheckpointRestoreAgeSeconds *int32              `json:"maxCheckpointRestoreAgeSeconds,omitempty"`
-	TearDownVersionHash            string              `json:"tearDownVersionHash,omitempty"`
+	SavepointInfo                  SavepointInfo           `json:"savepointInfo,omitempty"`
+	SavepointPath                  string                  `json:"savepointPath,omitempty"`
+	SavepointDisabled              bool                    `json:"savepointDisabled"`
+	DeploymentMode                 DeploymentMode          `json:"deploymentMode,omitempty"`
+	ExecutionMode                  ExecutionMode           `json:"executionMode,omitempty"`
+	RPCPort                        *int32                  `json:"rpcPort,omitempty"`
+	BlobPort                       *int32                  `json:"blobPort,omitempty"`
+	QueryPort                      *int32                  `json:"queryPort,omitempty"`
+	UIPort                         *int32                  `json:"uiPort,omitempty"`
+	MetricsQueryPort               *int32                  `json:"metricsQueryPort,omitempty"`
+	Volumes                        []apiv1.Volume          `json:"volumes,omitempty"`
+	VolumeMounts                   []apiv1.VolumeMount     `json:"volumeMounts,omitempty"`
+	RestartNonce                   string                  `json:"restartNonce"`
+	SavepointNonce                 string                  `json:"savepointNonce,omitempty"`
+	DeleteMode                     DeleteMode              `json:"deleteMode,omitempty"`
+	AllowNonRestoredState          bool                    `json:"allowNonRestoredState,omitempty"`
+	ForceRollback                  bool                    `json:"forceRollback"`
+	MaxCheckpointRestoreAgeSeconds *int32                  `json:"maxCheckpointRestoreAgeSeconds,omitempty"`
+	TearDownVersionHash            string                  `json:"tearDownVersionHash,omitempty"`
+	HighAvailability               *HighAvailabilityConfig `json:"highAvailability,omitempty"`
+	Autoscaler                     *AutoscalerConfig       `json:"autoscaler,omitempty"`
+	SavepointSchedule              *SavepointSchedule      `json:"savepointSchedule,omitempty"`
+	RestoreFrom                    *RestoreFrom            `json:"restoreFrom,omitempty"`
+	BlueGreen                      *BlueGreenConfig        `json:"blueGreen,omitempty"`
+	AutoRollback                   *AutoRollbackPolicy     `json:"autoRollback,omitempty"`
+	DesiredState                   DesiredState            `json:"desiredState,omitempty"`
+	BoundedJob                     *BoundedJobConfig       `json:"boundedJob,omitempty"`
+	Jobs                           []SessionJob            `json:"jobs,omitempty"`
+	PodDisruptionBudget            *DisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
 }
 
 type FlinkConfig map[string]interface{}
@@ -112,6 +127,22 @@ type JobManagerConfig struct {
 	OffHeapMemoryFraction *float64                    `json:"offHeapMemoryFraction,omitempty"`
 	NodeSelector          map[string]string           `json:"nodeSelector,omitempty"`
 	Tolerations           []apiv1.Toleration          `json:"tolerations,omitempty"`
+	// Volumes of the pod in addition to the volumes of the application. A volume with the same name as one of the
+	// application's replaces it.
+	Volumes []apiv1.Volume `json:"volumes,omitempty"`
+	// Volume mounts of the Flink container in addition to the volume mounts of the application. A mount at the same
+	// path as one of the application's replaces it.
+	VolumeMounts []apiv1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Additional containers of the pod, e.g. log shippers. They share the volumes and volume mounts of the
+	// Flink container, and receive the Flink config rendered by the operator in their OPERATOR_FLINK_CONFIG env var if
+	// they declare it without a value.
+	Sidecars []apiv1.Container `json:"sidecars,omitempty"`
+	// Containers that run before the Flink container is started, e.g. to fetch secrets. They share the volumes of
+	// the application in the same way as sidecars.
+	InitContainers []apiv1.Container `json:"initContainers,omitempty"`
+	// Strategically merged into the pod generated by the operator. The operator's labels, and the image, args,
+	// ports, resources and environment of the Flink container, take precedence over the values set here.
+	PodTemplate *apiv1.PodTemplateSpec `json:"podTemplate,omitempty"`
 }
 
 type TaskManagerConfig struct {
@@ -121,6 +152,153 @@ type TaskManagerConfig struct {
 	OffHeapMemoryFraction *float64                    `json:"offHeapMemoryFraction,omitempty"`
 	NodeSelector          map[string]string           `json:"nodeSelector,omitempty"`
 	Tolerations           []apiv1.Toleration          `json:"tolerations,omitempty"`
+	// Volumes of the pod in addition to the volumes of the application. A volume with the same name as one of the
+	// application's replaces it.
+	Volumes []apiv1.Volume `json:"volumes,omitempty"`
+	// Volume mounts of the Flink container in addition to the volume mounts of the application. A mount at the same
+	// path as one of the application's replaces it.
+	VolumeMounts []apiv1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Additional containers of the pod, e.g. log shippers. They share the volumes and volume mounts of the
+	// Flink container, and receive the Flink config rendered by the operator in their OPERATOR_FLINK_CONFIG env var if
+	// they declare it without a value.
+	Sidecars []apiv1.Container `json:"sidecars,omitempty"`
+	// Containers that run before the Flink container is started, e.g. to fetch secrets. They share the volumes of
+	// the application in the same way as sidecars.
+	InitContainers []apiv1.Container `json:"initContainers,omitempty"`
+	// Strategically merged into the pod generated by the operator. The operator's labels, and the image, args,
+	// ports, resources and environment of the Flink container, take precedence over the values set here.
+	PodTemplate *apiv1.PodTemplateSpec `json:"podTemplate,omitempty"`
+	// Runs the task managers as a StatefulSet instead of a Deployment, if set
+	StatefulSet *TaskManagerStatefulSetConfig `json:"statefulSet,omitempty"`
+}
+
+// Configures task managers that run as a StatefulSet, which gives each of them a stable identity and its own
+// persistent volume claims that are kept when its pod is rescheduled, e.g. for Flink's local recovery.
+type TaskManagerStatefulSetConfig struct {
+	// Claims created for each task manager, which the volumeMounts of the task manager config can refer to by name.
+	// The claims of a cluster are deleted along with it.
+	VolumeClaimTemplates []apiv1.PersistentVolumeClaim `json:"volumeClaimTemplates,omitempty"`
+}
+
+// Configures Flink's Kubernetes high-availability services, which store the leader information in ConfigMaps
+// instead of requiring a ZooKeeper cluster.
+type HighAvailabilityConfig struct {
+	// The base directory for the job manager metadata. Each version of the application uses its own
+	// sub-directory of this.
+	StorageDir string `json:"storageDir"`
+}
+
+// Configures the operator to adjust the parallelism of the job (and with it the number of task managers) based on
+// metrics reported by the job. Each change is rolled out as an update of the application.
+type AutoscalerConfig struct {
+	MinParallelism int32 `json:"minParallelism"`
+	MaxParallelism int32 `json:"maxParallelism"`
+	// The utilization, between 0 and 1, that the autoscaler sizes the job for
+	TargetUtilization *float64 `json:"targetUtilization,omitempty"`
+	// The metrics that the utilization is derived from; the most utilized one determines the parallelism
+	Metrics []AutoscalerMetric `json:"metrics,omitempty"`
+	// The consumer lag that is considered full utilization when using the KafkaLag metric
+	MaxKafkaLag *int64 `json:"maxKafkaLag,omitempty"`
+	// The minimum time between two scaling decisions, and between the start of the job and the first decision
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+type AutoscalerMetric string
+
+const (
+	// The fraction of time the tasks of the busiest vertex spend processing records
+	AutoscalerMetricBusyTime AutoscalerMetric = "BusyTime"
+	// The fraction of time the tasks of the most backpressured vertex are blocked by their downstream tasks
+	AutoscalerMetricBackpressure AutoscalerMetric = "Backpressure"
+	// The consumer lag of the Kafka sources relative to maxKafkaLag
+	AutoscalerMetricKafkaLag AutoscalerMetric = "KafkaLag"
+)
+
+// Takes savepoints of the running job periodically, in addition to those taken during updates
+type SavepointSchedule struct {
+	// The interval between two scheduled savepoints
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// The number of successful scheduled savepoints that are retained
+	RetainCount *int32 `json:"retainCount,omitempty"`
+	// Scheduled savepoints older than this are expired, regardless of retainCount
+	RetainFor *metav1.Duration `json:"retainFor,omitempty"`
+	// Whether the savepoint directories of expired savepoints are deleted, rather than only removed from the status
+	DeleteExpired bool `json:"deleteExpired,omitempty"`
+}
+
+// Refers to an entry of status.savepointHistory that the job is started from on deploys
+type RestoreFrom struct {
+	// The position of the entry in the history, where 0 is the most recent savepoint
+	Index *int32 `json:"index,omitempty"`
+	// The hash of the application version whose most recent savepoint is used
+	Hash string `json:"hash,omitempty"`
+}
+
+// The savepoint that spec.restoreFrom was resolved to when the deploy it applies to started
+type RestoreFromStatus struct {
+	// The index and hash that spec.restoreFrom was set to
+	Index *int32 `json:"index,omitempty"`
+	Hash  string `json:"hash,omitempty"`
+	// The savepoint the job is started from
+	Path string `json:"path"`
+	// Whether a job has been started from the savepoint, after which spec.restoreFrom is ignored until it is changed
+	Restored bool `json:"restored,omitempty"`
+}
+
+type BlueGreenConfig struct {
+	// Promotes or rolls back new versions automatically, instead of waiting for tearDownVersionHash to be set
+	PromotionPolicy *PromotionPolicy `json:"promotionPolicy,omitempty"`
+}
+
+type PromotionPolicy struct {
+	// How long the job of the new version is observed before it is promoted
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty"`
+	// The number of times the job of the new version may restart during the soak window
+	MaxJobRestarts *int32 `json:"maxJobRestarts,omitempty"`
+	// The number of checkpoints that the job of the new version has to complete before it is promoted
+	MinCompletedCheckpoints *int32 `json:"minCompletedCheckpoints,omitempty"`
+	// The number of checkpoints of the job of the new version that may fail before it is promoted
+	MaxFailedCheckpoints *int32 `json:"maxFailedCheckpoints,omitempty"`
+}
+
+// Rolls back a new version that fails a health check shortly after it was deployed
+type AutoRollbackPolicy struct {
+	// How long a new version is monitored after it was deployed
+	Window *metav1.Duration `json:"window,omitempty"`
+	// The number of times the job may restart within the window
+	MaxJobRestarts *int32 `json:"maxJobRestarts,omitempty"`
+	// The number of checkpoints that may fail in a row
+	MaxConsecutiveFailedCheckpoints *int32 `json:"maxConsecutiveFailedCheckpoints,omitempty"`
+	// How long task managers may miss heartbeats before the version is considered unhealthy
+	TaskManagerHeartbeatTimeout *metav1.Duration `json:"taskManagerHeartbeatTimeout,omitempty"`
+}
+
+// Configures the application for a job that processes bounded input, and finishes once it has done so
+type BoundedJobConfig struct {
+	// How long the cluster is kept after the job has finished or failed, so that its logs and web UI remain available
+	TTLAfterFinished *metav1.Duration `json:"ttlAfterFinished,omitempty"`
+}
+
+// Configures the PodDisruptionBudget the operator creates for the task manager pods of each cluster. Clusters with
+// standby job managers also get a budget that lets one job manager be evicted at a time.
+type DisruptionBudgetConfig struct {
+	// How many of the pods may be evicted at the same time, as a number or a percentage. Defaults to 10% of the task
+	// managers, rounded up, and at least one.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// An additional job that runs on the session cluster of the application, next to the main job. Each job is stopped
+// with a savepoint and submitted again from it when its spec changes, without affecting the other jobs.
+type SessionJob struct {
+	// Identifies the job in the status, so it must be unique within the application
+	Name                  string `json:"name"`
+	JarName               string `json:"jarName"`
+	Parallelism           int32  `json:"parallelism"`
+	EntryClass            string `json:"entryClass,omitempty"`
+	ProgramArgs           string `json:"programArgs,omitempty"`
+	AllowNonRestoredState bool   `json:"allowNonRestoredState,omitempty"`
+	// The savepoint that the job is started from when it is first submitted
+	SavepointPath string `json:"savepointPath,omitempty"`
 }
 
 type EnvironmentConfig struct {
@@ -185,11 +363,196 @@ type FlinkApplicationStatus struct {
 	TeardownHash       string                          `json:"teardownHash,omitempty"`
 	SavepointTriggerID string                          `json:"savepointTriggerId,omitempty"`
 	SavepointPath      string                          `json:"savepointPath,omitempty"`
+	JarID              string                          `json:"jarID,omitempty"`
+	JarHash            string                          `json:"jarHash,omitempty"`
 	RetryCount         int32                           `json:"retryCount,omitempty"`
 	LastSeenError      *FlinkApplicationError          `json:"lastSeenError,omitempty"`
 	// We store deployment mode in the status to prevent incompatible migrations from
 	// Dual --> BlueGreen and BlueGreen --> Dual
-	DeploymentMode DeploymentMode `json:"deploymentMode,omitempty"`
+	DeploymentMode    DeploymentMode              `json:"deploymentMode,omitempty"`
+	Conditions        []FlinkApplicationCondition `json:"conditions,omitempty"`
+	Autoscaler        *AutoscalerStatus           `json:"autoscaler,omitempty"`
+	SavepointSchedule *SavepointScheduleStatus    `json:"savepointSchedule,omitempty"`
+	ManualSavepoint   *ManualSavepoint            `json:"manualSavepoint,omitempty"`
+	// The most recent savepoints taken of the job, oldest first
+	SavepointHistory []SavepointRecord `json:"savepointHistory,omitempty"`
+	// The savepoint of the history that spec.restoreFrom was resolved to
+	RestoreFrom *RestoreFromStatus `json:"restoreFrom,omitempty"`
+	// The evaluation of the new version by spec.blueGreen.promotionPolicy
+	Promotion *PromotionStatus `json:"promotion,omitempty"`
+	// The health of the version that was last deployed, as monitored by spec.autoRollback
+	AutoRollback *AutoRollbackStatus `json:"autoRollback,omitempty"`
+	// The final state of a bounded job, once it has finished or failed
+	FinishedJob *FinishedJobStatus `json:"finishedJob,omitempty"`
+	// The status of the jobs in spec.jobs, in the same order
+	Jobs []SessionJobStatus `json:"jobs,omitempty"`
+}
+
+type SavepointScheduleStatus struct {
+	LastTriggerTime *metav1.Time `json:"lastTriggerTime,omitempty"`
+	// The retained scheduled savepoints along with any that are in progress or recently failed, oldest first
+	Savepoints []ScheduledSavepoint `json:"savepoints,omitempty"`
+}
+
+type ScheduledSavepoint struct {
+	TriggerID      string         `json:"triggerId,omitempty"`
+	JobID          string         `json:"jobId,omitempty"`
+	TriggerTime    metav1.Time    `json:"triggerTime"`
+	CompletionTime *metav1.Time   `json:"completionTime,omitempty"`
+	State          SavepointState `json:"state"`
+	Location       string         `json:"location,omitempty"`
+	FailureCause   string         `json:"failureCause,omitempty"`
+	// Why deleting the savepoint failed once it expired. The deletion is not retried.
+	DeletionFailure string `json:"deletionFailure,omitempty"`
+}
+
+type FinishedJobStatus struct {
+	// The hash of the version that ran the job
+	Hash       string         `json:"hash"`
+	JobStatus  FlinkJobStatus `json:"jobStatus"`
+	FinishTime metav1.Time    `json:"finishTime"`
+	// The restartNonce that the job was run with; the job is run again once it changes
+	RestartNonce string `json:"restartNonce,omitempty"`
+	// Whether the cluster has been deleted, once the TTL has passed
+	ClusterDeleted bool `json:"clusterDeleted,omitempty"`
+}
+
+type SessionJobStatus struct {
+	Name string `json:"name"`
+	// The hash of the job spec that the job was last submitted with
+	Hash      string         `json:"hash,omitempty"`
+	JobStatus FlinkJobStatus `json:"jobStatus"`
+	// The savepoint being taken to stop the job, and the savepoint it is submitted from next
+	SavepointTriggerID string `json:"savepointTriggerId,omitempty"`
+	SavepointPath      string `json:"savepointPath,omitempty"`
+}
+
+type AutoRollbackStatus struct {
+	// The hash of the version that is monitored, and of the version it replaced
+	Hash         string `json:"hash"`
+	PreviousHash string `json:"previousHash"`
+	// The job of the previous version, which is submitted again on a rollback
+	PreviousJob FlinkJobStatus `json:"previousJob"`
+	// The savepoint that the previous job is restored from on a rollback
+	SavepointPath string      `json:"savepointPath,omitempty"`
+	DeployTime    metav1.Time `json:"deployTime"`
+	// The restart count of the job when it was first observed after being deployed
+	InitialJobRestartCount *int32 `json:"initialJobRestartCount,omitempty"`
+	// The checkpoint counts of the job when its last checkpoint completed
+	CompletedCheckpointCount int32 `json:"completedCheckpointCount,omitempty"`
+	FailedCheckpointCount    int32 `json:"failedCheckpointCount,omitempty"`
+	// The time since which not all task managers have been sending heartbeats
+	UnhealthyTaskManagersSince *metav1.Time `json:"unhealthyTaskManagersSince,omitempty"`
+	// The health check that failed, causing the version to be rolled back
+	FailedCheck string `json:"failedCheck,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+type PromotionStatus struct {
+	// The hash of the version that is evaluated
+	Hash          string      `json:"hash"`
+	SoakStartTime metav1.Time `json:"soakStartTime"`
+	// The restart count of the job of the new version when the soak window started
+	InitialJobRestartCount int32             `json:"initialJobRestartCount,omitempty"`
+	Decision               PromotionDecision `json:"decision"`
+	Reason                 string            `json:"reason,omitempty"`
+	DecisionTime           *metav1.Time      `json:"decisionTime,omitempty"`
+}
+
+type PromotionDecision string
+
+const (
+	PromotionPending    PromotionDecision = "Pending"
+	PromotionPromoted   PromotionDecision = "Promoted"
+	PromotionRolledBack PromotionDecision = "RolledBack"
+)
+
+type SavepointRecord struct {
+	Path   string          `json:"path"`
+	Reason SavepointReason `json:"reason"`
+	JobID  string          `json:"jobId,omitempty"`
+	// The hash of the application version that the savepoint was taken of
+	Hash string      `json:"hash,omitempty"`
+	Time metav1.Time `json:"time"`
+}
+
+type SavepointReason string
+
+const (
+	// Taken of the previous job during an update
+	SavepointReasonUpdate SavepointReason = "Update"
+	// The externalized checkpoint used after a savepoint failed during an update
+	SavepointReasonRecovery SavepointReason = "Recovery"
+	// Taken when the application was deleted
+	SavepointReasonDelete SavepointReason = "Delete"
+	// Taken according to spec.savepointSchedule
+	SavepointReasonScheduled SavepointReason = "Scheduled"
+	// Taken for a change of spec.savepointNonce
+	SavepointReasonManual SavepointReason = "Manual"
+	// Taken when the application was suspended
+	SavepointReasonSuspend SavepointReason = "Suspend"
+)
+
+// The savepoint taken for the current spec.savepointNonce
+type ManualSavepoint struct {
+	Nonce          string         `json:"nonce"`
+	TriggerID      string         `json:"triggerId,omitempty"`
+	JobID          string         `json:"jobId,omitempty"`
+	TriggerTime    metav1.Time    `json:"triggerTime"`
+	CompletionTime *metav1.Time   `json:"completionTime,omitempty"`
+	State          SavepointState `json:"state"`
+	Location       string         `json:"location,omitempty"`
+	FailureCause   string         `json:"failureCause,omitempty"`
+}
+
+type SavepointState string
+
+const (
+	SavepointInProgress SavepointState = "InProgress"
+	SavepointSucceeded  SavepointState = "Succeeded"
+	SavepointFailed     SavepointState = "Failed"
+)
+
+type AutoscalerStatus struct {
+	// The parallelism chosen by the autoscaler. The operator pins it in the flink-autoscaled-parallelism annotation
+	// of the application, from which it takes precedence over spec.parallelism.
+	Parallelism int32 `json:"parallelism,omitempty"`
+	// The spec.parallelism at the time of that choice. A change to spec.parallelism discards the chosen parallelism.
+	SpecParallelism int32        `json:"specParallelism,omitempty"`
+	LastScaleTime   *metav1.Time `json:"lastScaleTime,omitempty"`
+	// The most recent scaling decisions, oldest first
+	History []AutoscalerDecision `json:"history,omitempty"`
+}
+
+type AutoscalerDecision struct {
+	Time            metav1.Time      `json:"time"`
+	FromParallelism int32            `json:"fromParallelism"`
+	ToParallelism   int32            `json:"toParallelism"`
+	Metric          AutoscalerMetric `json:"metric"`
+	Message         string           `json:"message,omitempty"`
+}
+
+type FlinkApplicationConditionType string
+
+const (
+	// The Flink cluster for the current version of the application is up
+	ConditionClusterReady FlinkApplicationConditionType = "ClusterReady"
+	// The Flink job for the current version of the application is running
+	ConditionJobRunning FlinkApplicationConditionType = "JobRunning"
+	// The last savepoint taken by the operator succeeded
+	ConditionSavepointSucceeded FlinkApplicationConditionType = "SavepointSucceeded"
+	// The application is being deployed, updated or deleted
+	ConditionProgressing FlinkApplicationConditionType = "Progressing"
+	// The last deploy failed, or the operator is encountering errors managing the application
+	ConditionDegraded FlinkApplicationConditionType = "Degraded"
+)
+
+type FlinkApplicationCondition struct {
+	Type               FlinkApplicationConditionType `json:"type"`
+	Status             apiv1.ConditionStatus         `json:"status"`
+	LastTransitionTime metav1.Time                   `json:"lastTransitionTime,omitempty"`
+	Reason             string                        `json:"reason,omitempty"`
+	Message            string                        `json:"message,omitempty"`
 }
 
 type FlinkApplicationVersion string
@@ -226,6 +589,38 @@ func (in *FlinkApplicationStatus) TouchResource(reason string) {
 	in.Reason = reason
 }
 
+func (in *FlinkApplicationStatus) GetCondition(conditionType FlinkApplicationConditionType) *FlinkApplicationCondition {
+	for i := range in.Conditions {
+		if in.Conditions[i].Type == conditionType {
+			return &in.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// Sets the condition of the given type, returning whether it has changed. The transition time is only updated when
+// the status of the condition changes.
+func (in *FlinkApplicationStatus) SetCondition(conditionType FlinkApplicationConditionType, status apiv1.ConditionStatus,
+	reason string, message string) bool {
+	condition := in.GetCondition(conditionType)
+	if condition == nil {
+		in.Conditions = append(in.Conditions, FlinkApplicationCondition{Type: conditionType})
+		condition = &in.Conditions[len(in.Conditions)-1]
+	}
+
+	if condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return false
+	}
+
+	if condition.Status != status {
+		condition.Status = status
+		condition.LastTransitionTime = metav1.Now()
+	}
+	condition.Reason = reason
+	condition.Message = message
+	return true
+}
+
 type FlinkApplicationPhase string
 
 func (p FlinkApplicationPhase) VerboseString() string {
@@ -250,6 +645,10 @@ const (
 	FlinkApplicationRollingBackJob  FlinkApplicationPhase = "RollingBackJob"
 	FlinkApplicationDeployFailed    FlinkApplicationPhase = "DeployFailed"
 	FlinkApplicationDualRunning     FlinkApplicationPhase = "DualRunning"
+	FlinkApplicationSuspending      FlinkApplicationPhase = "Suspending"
+	FlinkApplicationSuspended       FlinkApplicationPhase = "Suspended"
+	FlinkApplicationSucceeded       FlinkApplicationPhase = "Succeeded"
+	FlinkApplicationFailed          FlinkApplicationPhase = "Failed"
 )
 
 var FlinkApplicationPhases = []FlinkApplicationPhase{
@@ -265,12 +664,21 @@ var FlinkApplicationPhases = []FlinkApplicationPhase{
 	FlinkApplicationDeployFailed,
 	FlinkApplicationRollingBackJob,
 	FlinkApplicationDualRunning,
+	FlinkApplicationSuspending,
+	FlinkApplicationSuspended,
+	FlinkApplicationSucceeded,
+	FlinkApplicationFailed,
 }
 
 func IsRunningPhase(phase FlinkApplicationPhase) bool {
 	return phase == FlinkApplicationRunning || phase == FlinkApplicationDeployFailed
 }
 
+// The phases that a bounded job ends in, once it has finished or failed
+func IsFinishedPhase(phase FlinkApplicationPhase) bool {
+	return phase == FlinkApplicationSucceeded || phase == FlinkApplicationFailed
+}
+
 func IsBlueGreenDeploymentMode(mode DeploymentMode) bool {
 	// Backaward compatibility between v1beta1 and v1beta1
 	if mode == DeploymentModeDual {
@@ -279,6 +687,10 @@ func IsBlueGreenDeploymentMode(mode DeploymentMode) bool {
 	return mode == DeploymentModeBlueGreen
 }
 
+func IsApplicationExecutionMode(mode ExecutionMode) bool {
+	return mode == ExecutionModeApplication
+}
+
 func GetMaxRunningJobs(mode DeploymentMode) int32 {
 	if IsBlueGreenDeploymentMode(mode) {
 		return int32(2)
@@ -294,6 +706,15 @@ const (
 	DeploymentModeBlueGreen DeploymentMode = "BlueGreen"
 )
 
+type ExecutionMode string
+
+const (
+	// The job manager runs a session cluster, to which the operator submits the job
+	ExecutionModeSession ExecutionMode = "Session"
+	// The job manager runs the job itself (Flink's application mode), so the job is started together with the cluster
+	ExecutionModeApplication ExecutionMode = "Application"
+)
+
 type DeleteMode string
 
 const (
@@ -302,6 +723,14 @@ const (
 	DeleteModeNone        DeleteMode = "None"
 )
 
+type DesiredState string
+
+const (
+	DesiredStateRunning DesiredState = "Running"
+	// The job is stopped with a savepoint and its cluster is deleted, until the state is set back to Running
+	DesiredStateSuspended DesiredState = "Suspended"
+)
+
 type HealthStatus string
 
 const (
@@ -355,4 +784,6 @@ const (
 	GetCheckpointCounts    FlinkMethod = "GetCheckpointCounts"
 	GetJobOverview         FlinkMethod = "GetJobOverview"
 	SavepointJob           FlinkMethod = "SavepointJob"
+	GetJobVertexMetrics    FlinkMethod = "GetJobVertexMetrics"
+	UploadJar              FlinkMethod = "UploadJar"
 )
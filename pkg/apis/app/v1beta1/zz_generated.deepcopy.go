@@ -6,9 +6,217 @@ package v1beta1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollbackPolicy) DeepCopyInto(out *AutoRollbackPolicy) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxJobRestarts != nil {
+		in, out := &in.MaxJobRestarts, &out.MaxJobRestarts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConsecutiveFailedCheckpoints != nil {
+		in, out := &in.MaxConsecutiveFailedCheckpoints, &out.MaxConsecutiveFailedCheckpoints
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TaskManagerHeartbeatTimeout != nil {
+		in, out := &in.TaskManagerHeartbeatTimeout, &out.TaskManagerHeartbeatTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollbackPolicy.
+func (in *AutoRollbackPolicy) DeepCopy() *AutoRollbackPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollbackPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollbackStatus) DeepCopyInto(out *AutoRollbackStatus) {
+	*out = *in
+	in.PreviousJob.DeepCopyInto(&out.PreviousJob)
+	in.DeployTime.DeepCopyInto(&out.DeployTime)
+	if in.InitialJobRestartCount != nil {
+		in, out := &in.InitialJobRestartCount, &out.InitialJobRestartCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnhealthyTaskManagersSince != nil {
+		in, out := &in.UnhealthyTaskManagersSince, &out.UnhealthyTaskManagersSince
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollbackStatus.
+func (in *AutoRollbackStatus) DeepCopy() *AutoRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerConfig) DeepCopyInto(out *AutoscalerConfig) {
+	*out = *in
+	if in.TargetUtilization != nil {
+		in, out := &in.TargetUtilization, &out.TargetUtilization
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]AutoscalerMetric, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxKafkaLag != nil {
+		in, out := &in.MaxKafkaLag, &out.MaxKafkaLag
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerConfig.
+func (in *AutoscalerConfig) DeepCopy() *AutoscalerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerDecision) DeepCopyInto(out *AutoscalerDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerDecision.
+func (in *AutoscalerDecision) DeepCopy() *AutoscalerDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerStatus) DeepCopyInto(out *AutoscalerStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]AutoscalerDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerStatus.
+func (in *AutoscalerStatus) DeepCopy() *AutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenConfig) DeepCopyInto(out *BlueGreenConfig) {
+	*out = *in
+	if in.PromotionPolicy != nil {
+		in, out := &in.PromotionPolicy, &out.PromotionPolicy
+		*out = new(PromotionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenConfig.
+func (in *BlueGreenConfig) DeepCopy() *BlueGreenConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BoundedJobConfig) DeepCopyInto(out *BoundedJobConfig) {
+	*out = *in
+	if in.TTLAfterFinished != nil {
+		in, out := &in.TTLAfterFinished, &out.TTLAfterFinished
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BoundedJobConfig.
+func (in *BoundedJobConfig) DeepCopy() *BoundedJobConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BoundedJobConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisruptionBudgetConfig) DeepCopyInto(out *DisruptionBudgetConfig) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptionBudgetConfig.
+func (in *DisruptionBudgetConfig) DeepCopy() *DisruptionBudgetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptionBudgetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvironmentConfig) DeepCopyInto(out *EnvironmentConfig) {
 	*out = *in
@@ -39,6 +247,24 @@ func (in *EnvironmentConfig) DeepCopy() *EnvironmentConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FinishedJobStatus) DeepCopyInto(out *FinishedJobStatus) {
+	*out = *in
+	in.JobStatus.DeepCopyInto(&out.JobStatus)
+	in.FinishTime.DeepCopyInto(&out.FinishTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FinishedJobStatus.
+func (in *FinishedJobStatus) DeepCopy() *FinishedJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FinishedJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FlinkApplication) DeepCopyInto(out *FlinkApplication) {
 	*out = *in
@@ -67,6 +293,23 @@ func (in *FlinkApplication) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationCondition) DeepCopyInto(out *FlinkApplicationCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationCondition.
+func (in *FlinkApplicationCondition) DeepCopy() *FlinkApplicationCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FlinkApplicationError) DeepCopyInto(out *FlinkApplicationError) {
 	*out = *in
@@ -181,6 +424,51 @@ func (in *FlinkApplicationSpec) DeepCopyInto(out *FlinkApplicationSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.HighAvailability != nil {
+		in, out := &in.HighAvailability, &out.HighAvailability
+		*out = new(HighAvailabilityConfig)
+		**out = **in
+	}
+	if in.Autoscaler != nil {
+		in, out := &in.Autoscaler, &out.Autoscaler
+		*out = new(AutoscalerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SavepointSchedule != nil {
+		in, out := &in.SavepointSchedule, &out.SavepointSchedule
+		*out = new(SavepointSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RestoreFrom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollbackPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BoundedJob != nil {
+		in, out := &in.BoundedJob, &out.BoundedJob
+		*out = new(BoundedJobConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]SessionJob, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(DisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -219,6 +507,62 @@ func (in *FlinkApplicationStatus) DeepCopyInto(out *FlinkApplicationStatus) {
 		*out = new(FlinkApplicationError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]FlinkApplicationCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Autoscaler != nil {
+		in, out := &in.Autoscaler, &out.Autoscaler
+		*out = new(AutoscalerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SavepointSchedule != nil {
+		in, out := &in.SavepointSchedule, &out.SavepointSchedule
+		*out = new(SavepointScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManualSavepoint != nil {
+		in, out := &in.ManualSavepoint, &out.ManualSavepoint
+		*out = new(ManualSavepoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SavepointHistory != nil {
+		in, out := &in.SavepointHistory, &out.SavepointHistory
+		*out = make([]SavepointRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RestoreFromStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Promotion != nil {
+		in, out := &in.Promotion, &out.Promotion
+		*out = new(PromotionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FinishedJob != nil {
+		in, out := &in.FinishedJob, &out.FinishedJob
+		*out = new(FinishedJobStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]SessionJobStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -298,6 +642,22 @@ func (in *FlinkJobStatus) DeepCopy() *FlinkJobStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailabilityConfig) DeepCopyInto(out *HighAvailabilityConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailabilityConfig.
+func (in *HighAvailabilityConfig) DeepCopy() *HighAvailabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobManagerConfig) DeepCopyInto(out *JobManagerConfig) {
 	*out = *in
@@ -331,6 +691,39 @@ func (in *JobManagerConfig) DeepCopyInto(out *JobManagerConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(v1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -344,6 +737,126 @@ func (in *JobManagerConfig) DeepCopy() *JobManagerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManualSavepoint) DeepCopyInto(out *ManualSavepoint) {
+	*out = *in
+	in.TriggerTime.DeepCopyInto(&out.TriggerTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManualSavepoint.
+func (in *ManualSavepoint) DeepCopy() *ManualSavepoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ManualSavepoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
+	*out = *in
+	if in.SoakDuration != nil {
+		in, out := &in.SoakDuration, &out.SoakDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxJobRestarts != nil {
+		in, out := &in.MaxJobRestarts, &out.MaxJobRestarts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinCompletedCheckpoints != nil {
+		in, out := &in.MinCompletedCheckpoints, &out.MinCompletedCheckpoints
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxFailedCheckpoints != nil {
+		in, out := &in.MaxFailedCheckpoints, &out.MaxFailedCheckpoints
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPolicy.
+func (in *PromotionPolicy) DeepCopy() *PromotionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
+	*out = *in
+	in.SoakStartTime.DeepCopyInto(&out.SoakStartTime)
+	if in.DecisionTime != nil {
+		in, out := &in.DecisionTime, &out.DecisionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
+func (in *PromotionStatus) DeepCopy() *PromotionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFrom) DeepCopyInto(out *RestoreFrom) {
+	*out = *in
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFrom.
+func (in *RestoreFrom) DeepCopy() *RestoreFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFromStatus) DeepCopyInto(out *RestoreFromStatus) {
+	*out = *in
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFromStatus.
+func (in *RestoreFromStatus) DeepCopy() *RestoreFromStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFromStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SavepointInfo) DeepCopyInto(out *SavepointInfo) {
 	*out = *in
@@ -360,6 +873,135 @@ func (in *SavepointInfo) DeepCopy() *SavepointInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SavepointRecord) DeepCopyInto(out *SavepointRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SavepointRecord.
+func (in *SavepointRecord) DeepCopy() *SavepointRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(SavepointRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SavepointSchedule) DeepCopyInto(out *SavepointSchedule) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetainCount != nil {
+		in, out := &in.RetainCount, &out.RetainCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetainFor != nil {
+		in, out := &in.RetainFor, &out.RetainFor
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SavepointSchedule.
+func (in *SavepointSchedule) DeepCopy() *SavepointSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SavepointSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SavepointScheduleStatus) DeepCopyInto(out *SavepointScheduleStatus) {
+	*out = *in
+	if in.LastTriggerTime != nil {
+		in, out := &in.LastTriggerTime, &out.LastTriggerTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Savepoints != nil {
+		in, out := &in.Savepoints, &out.Savepoints
+		*out = make([]ScheduledSavepoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SavepointScheduleStatus.
+func (in *SavepointScheduleStatus) DeepCopy() *SavepointScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SavepointScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledSavepoint) DeepCopyInto(out *ScheduledSavepoint) {
+	*out = *in
+	in.TriggerTime.DeepCopyInto(&out.TriggerTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledSavepoint.
+func (in *ScheduledSavepoint) DeepCopy() *ScheduledSavepoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledSavepoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionJob) DeepCopyInto(out *SessionJob) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionJob.
+func (in *SessionJob) DeepCopy() *SessionJob {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionJobStatus) DeepCopyInto(out *SessionJobStatus) {
+	*out = *in
+	in.JobStatus.DeepCopyInto(&out.JobStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionJobStatus.
+func (in *SessionJobStatus) DeepCopy() *SessionJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskManagerConfig) DeepCopyInto(out *TaskManagerConfig) {
 	*out = *in
@@ -393,6 +1035,44 @@ func (in *TaskManagerConfig) DeepCopyInto(out *TaskManagerConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(v1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(TaskManagerStatefulSetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -405,3 +1085,26 @@ func (in *TaskManagerConfig) DeepCopy() *TaskManagerConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskManagerStatefulSetConfig) DeepCopyInto(out *TaskManagerStatefulSetConfig) {
+	*out = *in
+	if in.VolumeClaimTemplates != nil {
+		in, out := &in.VolumeClaimTemplates, &out.VolumeClaimTemplates
+		*out = make([]v1.PersistentVolumeClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskManagerStatefulSetConfig.
+func (in *TaskManagerStatefulSetConfig) DeepCopy() *TaskManagerStatefulSetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskManagerStatefulSetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
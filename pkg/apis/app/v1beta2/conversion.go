@@ -0,0 +1,115 @@
+package v1beta2
+
+import (
+	"encoding/json"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// The v1beta1 fields that have no equivalent in v1beta2. These are stored in an annotation when converting from
+// v1beta1, and restored when converting back.
+type v1beta1Fields struct {
+	SavepointInfo v1beta1.SavepointInfo `json:"savepointInfo,omitempty"`
+	// whether SavepointPath was taken from the deprecated SavepointInfo
+	SavepointPathFromInfo bool                   `json:"savepointPathFromInfo,omitempty"`
+	DeploymentMode        v1beta1.DeploymentMode `json:"deploymentMode,omitempty"`
+}
+
+// ConvertTo converts this FlinkApplication to the hub (v1beta1) version
+func (in *FlinkApplication) ConvertTo(dstRaw conversion.Hub) error {
+	out := dstRaw.(*v1beta1.FlinkApplication)
+
+	if err := Convert_v1beta2_FlinkApplication_To_v1beta1_FlinkApplication(in, out, nil); err != nil {
+		return err
+	}
+	out.Spec.SavepointInfo = v1beta1.SavepointInfo{}
+
+	data, ok := out.Annotations[v1beta1.ConversionDataAnnotation]
+	if !ok {
+		return nil
+	}
+
+	// the generated conversion shares the annotations with the source, so they are copied before being modified
+	annotations := map[string]string{}
+	for key, value := range in.Annotations {
+		if key != v1beta1.ConversionDataAnnotation {
+			annotations[key] = value
+		}
+	}
+	out.Annotations = nil
+	if len(annotations) > 0 {
+		out.Annotations = annotations
+	}
+
+	var fields v1beta1Fields
+	if err := json.Unmarshal([]byte(data), &fields); err != nil {
+		return err
+	}
+
+	out.Spec.SavepointInfo = fields.SavepointInfo
+	if fields.SavepointPathFromInfo && out.Spec.SavepointPath == fields.SavepointInfo.SavepointLocation {
+		out.Spec.SavepointPath = ""
+	}
+	// only restore the original mode if it has not been changed in the meantime
+	if out.Spec.DeploymentMode == v1beta1.DeploymentModeDual {
+		out.Spec.DeploymentMode = fields.DeploymentMode
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub (v1beta1) version to this version
+func (in *FlinkApplication) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.FlinkApplication)
+
+	if err := Convert_v1beta1_FlinkApplication_To_v1beta2_FlinkApplication(src, in, nil); err != nil {
+		return err
+	}
+
+	fields := v1beta1Fields{
+		SavepointInfo:         src.Spec.SavepointInfo,
+		SavepointPathFromInfo: src.Spec.SavepointPath == "" && src.Spec.SavepointInfo.SavepointLocation != "",
+		DeploymentMode:        v1beta1.DeploymentModeDual,
+	}
+	if src.Spec.DeploymentMode != v1beta1.DeploymentModeDual && src.Spec.DeploymentMode != v1beta1.DeploymentModeBlueGreen {
+		fields.DeploymentMode = src.Spec.DeploymentMode
+	}
+
+	if fields == (v1beta1Fields{DeploymentMode: v1beta1.DeploymentModeDual}) {
+		return nil
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	// the generated conversion shares the annotations with the source, so they are copied before being modified
+	annotations := map[string]string{}
+	for key, value := range src.Annotations {
+		annotations[key] = value
+	}
+	annotations[v1beta1.ConversionDataAnnotation] = string(data)
+	in.Annotations = annotations
+
+	return nil
+}
+
+// Convert_v1beta1_FlinkApplicationSpec_To_v1beta2_FlinkApplicationSpec falls back to the deprecated SavepointInfo
+// when SavepointPath is not set, and treats an unset mode and the removed Single mode as Dual.
+func Convert_v1beta1_FlinkApplicationSpec_To_v1beta2_FlinkApplicationSpec(in *v1beta1.FlinkApplicationSpec, out *FlinkApplicationSpec, s apiconversion.Scope) error { //nolint:golint
+	if err := autoConvert_v1beta1_FlinkApplicationSpec_To_v1beta2_FlinkApplicationSpec(in, out, s); err != nil {
+		return err
+	}
+
+	if in.SavepointPath == "" {
+		out.SavepointPath = in.SavepointInfo.SavepointLocation
+	}
+	if in.DeploymentMode != v1beta1.DeploymentModeBlueGreen {
+		out.DeploymentMode = DeploymentModeDual
+	}
+
+	return nil
+}
@@ -0,0 +1,399 @@
+package v1beta2
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func getV1beta1App() *v1beta1.FlinkApplication {
+	slots := int32(4)
+	port := int32(7000)
+	index := int32(1)
+	fraction := 0.3
+	maxUnavailable := intstr.FromString("25%")
+	now := metav1.Unix(1568000000, 0)
+
+	return &v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "app-name",
+			Namespace:   "ns",
+			Annotations: map[string]string{"key": "value"},
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			Image:              "flink-image",
+			ServiceAccountName: "flink",
+			FlinkConfig:        v1beta1.FlinkConfig{"akka.timeout": "5s", "state.backend.fs.threshold": 1024},
+			FlinkVersion:       "1.8",
+			TaskManagerConfig: v1beta1.TaskManagerConfig{
+				Resources: &apiv1.ResourceRequirements{
+					Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("2Gi")},
+				},
+				TaskSlots:             &slots,
+				OffHeapMemoryFraction: &fraction,
+				Tolerations:           []apiv1.Toleration{{Key: "dedicated", Value: "flink"}},
+				Volumes:               []apiv1.Volume{{Name: "rocksdb"}},
+				VolumeMounts:          []apiv1.VolumeMount{{Name: "rocksdb", MountPath: "/rocksdb"}},
+				Sidecars:              []apiv1.Container{{Name: "log-shipper", Image: "fluent-bit"}},
+				InitContainers:        []apiv1.Container{{Name: "fetch-secrets", Image: "vault"}},
+				StatefulSet: &v1beta1.TaskManagerStatefulSetConfig{
+					VolumeClaimTemplates: []apiv1.PersistentVolumeClaim{
+						{ObjectMeta: metav1.ObjectMeta{Name: "local-state"}},
+					},
+				},
+			},
+			JobManagerConfig: v1beta1.JobManagerConfig{
+				EnvConfig: v1beta1.EnvironmentConfig{
+					Env: []apiv1.EnvVar{{Name: "ENV", Value: "value"}},
+				},
+				NodeSelector: map[string]string{"pool": "flink"},
+				PodTemplate: &apiv1.PodTemplateSpec{
+					Spec: apiv1.PodSpec{PriorityClassName: "high-priority"},
+				},
+			},
+			JarName:        "job.jar",
+			JarURI:         "https://artifacts.example.com/job.jar",
+			JarChecksum:    "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			Parallelism:    8,
+			SavepointInfo:  v1beta1.SavepointInfo{SavepointLocation: "s3://savepoints/1"},
+			DeploymentMode: v1beta1.DeploymentModeSingle,
+			ExecutionMode:  v1beta1.ExecutionModeApplication,
+			RPCPort:        &port,
+			DeleteMode:     v1beta1.DeleteModeForceCancel,
+			SavepointNonce: "nonce-1",
+			HighAvailability: &v1beta1.HighAvailabilityConfig{
+				StorageDir: "s3://flink/ha",
+			},
+			Autoscaler: &v1beta1.AutoscalerConfig{
+				MinParallelism:    2,
+				MaxParallelism:    16,
+				TargetUtilization: &fraction,
+				Metrics:           []v1beta1.AutoscalerMetric{v1beta1.AutoscalerMetricBusyTime},
+				Cooldown:          &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			SavepointSchedule: &v1beta1.SavepointSchedule{
+				Interval:      &metav1.Duration{Duration: 6 * time.Hour},
+				RetainCount:   &slots,
+				RetainFor:     &metav1.Duration{Duration: 72 * time.Hour},
+				DeleteExpired: true,
+			},
+			RestoreFrom: &v1beta1.RestoreFrom{
+				Index: &index,
+			},
+			BlueGreen: &v1beta1.BlueGreenConfig{
+				PromotionPolicy: &v1beta1.PromotionPolicy{
+					SoakDuration:            &metav1.Duration{Duration: 15 * time.Minute},
+					MinCompletedCheckpoints: &index,
+				},
+			},
+			AutoRollback: &v1beta1.AutoRollbackPolicy{
+				Window:         &metav1.Duration{Duration: 20 * time.Minute},
+				MaxJobRestarts: &slots,
+			},
+			DesiredState: v1beta1.DesiredStateSuspended,
+			BoundedJob: &v1beta1.BoundedJobConfig{
+				TTLAfterFinished: &metav1.Duration{Duration: time.Hour},
+			},
+			PodDisruptionBudget: &v1beta1.DisruptionBudgetConfig{
+				MaxUnavailable: &maxUnavailable,
+			},
+			Jobs: []v1beta1.SessionJob{
+				{
+					Name:          "enrichment",
+					JarName:       "enrichment.jar",
+					Parallelism:   2,
+					ProgramArgs:   "--table users",
+					SavepointPath: "s3://savepoints/enrichment",
+				},
+			},
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:         v1beta1.FlinkApplicationRunning,
+			StartedAt:     &now,
+			DeployVersion: v1beta1.BlueFlinkApplication,
+			ClusterStatus: v1beta1.FlinkClusterStatus{
+				ClusterOverviewURL: "https://flink/app-name",
+				Health:             v1beta1.Green,
+			},
+			JobStatus: v1beta1.FlinkJobStatus{
+				JobID:        "job-id",
+				State:        v1beta1.Running,
+				StartTime:    &now,
+				RunningTasks: 8,
+			},
+			VersionStatuses: []v1beta1.FlinkApplicationVersionStatus{
+				{
+					Version:     v1beta1.BlueFlinkApplication,
+					VersionHash: "abcd1234",
+					JobStatus:   v1beta1.FlinkJobStatus{JobID: "job-id"},
+				},
+			},
+			DeployHash: "abcd1234",
+			JarID:      "5f3b1c2a-job.jar",
+			JarHash:    "abcd1234",
+			LastSeenError: &v1beta1.FlinkApplicationError{
+				AppError:  "error",
+				Method:    v1beta1.SubmitJob,
+				ErrorCode: "500",
+			},
+			DeploymentMode: v1beta1.DeploymentModeSingle,
+			Conditions: []v1beta1.FlinkApplicationCondition{
+				{
+					Type:               v1beta1.ConditionJobRunning,
+					Status:             apiv1.ConditionTrue,
+					LastTransitionTime: now,
+					Reason:             "JobRunning",
+				},
+			},
+			Autoscaler: &v1beta1.AutoscalerStatus{
+				Parallelism:     8,
+				SpecParallelism: 4,
+				LastScaleTime:   &now,
+				History: []v1beta1.AutoscalerDecision{
+					{
+						Time:            now,
+						FromParallelism: 4,
+						ToParallelism:   8,
+						Metric:          v1beta1.AutoscalerMetricBusyTime,
+						Message:         "utilization 0.95 is above the target of 0.3",
+					},
+				},
+			},
+			SavepointSchedule: &v1beta1.SavepointScheduleStatus{
+				LastTriggerTime: &now,
+				Savepoints: []v1beta1.ScheduledSavepoint{
+					{
+						TriggerID:       "trigger-1",
+						JobID:           "job-id",
+						TriggerTime:     now,
+						CompletionTime:  &now,
+						State:           v1beta1.SavepointSucceeded,
+						Location:        "s3://savepoints/savepoint-1",
+						DeletionFailure: "access denied",
+					},
+				},
+			},
+			ManualSavepoint: &v1beta1.ManualSavepoint{
+				Nonce:       "nonce-1",
+				TriggerID:   "trigger-2",
+				JobID:       "job-id",
+				TriggerTime: now,
+				State:       v1beta1.SavepointInProgress,
+			},
+			SavepointHistory: []v1beta1.SavepointRecord{
+				{
+					Path:   "s3://savepoints/savepoint-1",
+					Reason: v1beta1.SavepointReasonScheduled,
+					JobID:  "job-id",
+					Hash:   "abcd1234",
+					Time:   now,
+				},
+			},
+			RestoreFrom: &v1beta1.RestoreFromStatus{
+				Index:    &index,
+				Path:     "s3://savepoints/savepoint-1",
+				Restored: true,
+			},
+			Promotion: &v1beta1.PromotionStatus{
+				Hash:          "efgh5678",
+				SoakStartTime: now,
+				Decision:      v1beta1.PromotionRolledBack,
+				Reason:        "the job restarted 2 times during the soak window",
+				DecisionTime:  &now,
+			},
+			AutoRollback: &v1beta1.AutoRollbackStatus{
+				Hash:                     "abcd1234",
+				PreviousHash:             "0123abcd",
+				PreviousJob:              v1beta1.FlinkJobStatus{JarName: "job.jar", Parallelism: 4},
+				SavepointPath:            "s3://savepoints/savepoint-1",
+				DeployTime:               now,
+				InitialJobRestartCount:   &index,
+				CompletedCheckpointCount: 3,
+				FailedCheckpointCount:    1,
+			},
+			FinishedJob: &v1beta1.FinishedJobStatus{
+				Hash:           "abcd1234",
+				JobStatus:      v1beta1.FlinkJobStatus{JobID: "job-id", State: v1beta1.Finished},
+				FinishTime:     now,
+				RestartNonce:   "nonce-1",
+				ClusterDeleted: true,
+			},
+			Jobs: []v1beta1.SessionJobStatus{
+				{
+					Name:               "enrichment",
+					Hash:               "ijkl9012",
+					JobStatus:          v1beta1.FlinkJobStatus{JobID: "job-id-2", JarName: "enrichment.jar"},
+					SavepointTriggerID: "trigger-3",
+				},
+			},
+		},
+	}
+}
+
+func assertSemanticEqual(t *testing.T, expected interface{}, actual interface{}) {
+	assert.True(t, apiequality.Semantic.DeepEqual(expected, actual), diff.ObjectReflectDiff(expected, actual))
+}
+
+func TestConvertFromV1beta1(t *testing.T) {
+	hub := getV1beta1App()
+
+	app := &FlinkApplication{}
+	assert.Nil(t, app.ConvertFrom(hub))
+
+	// the deprecated fields are replaced by their v1beta2 equivalents
+	assert.Equal(t, "s3://savepoints/1", app.Spec.SavepointPath)
+	assert.Equal(t, DeploymentModeDual, app.Spec.DeploymentMode)
+	assert.Contains(t, app.Annotations, v1beta1.ConversionDataAnnotation)
+
+	// but the original is not modified
+	assert.NotContains(t, hub.Annotations, v1beta1.ConversionDataAnnotation)
+	assert.Equal(t, v1beta1.DeploymentModeSingle, hub.Spec.DeploymentMode)
+}
+
+func TestV1beta1RoundTrip(t *testing.T) {
+	for _, mode := range []v1beta1.DeploymentMode{"", v1beta1.DeploymentModeSingle, v1beta1.DeploymentModeDual,
+		v1beta1.DeploymentModeBlueGreen} {
+		hub := getV1beta1App()
+		hub.Spec.DeploymentMode = mode
+
+		app := &FlinkApplication{}
+		assert.Nil(t, app.ConvertFrom(hub))
+
+		result := &v1beta1.FlinkApplication{}
+		assert.Nil(t, app.ConvertTo(result))
+		assertSemanticEqual(t, hub, result)
+	}
+}
+
+func TestV1beta1RoundTripWithoutDeprecatedFields(t *testing.T) {
+	hub := getV1beta1App()
+	hub.Spec.SavepointInfo = v1beta1.SavepointInfo{}
+	hub.Spec.SavepointPath = "s3://savepoints/2"
+	hub.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+
+	app := &FlinkApplication{}
+	assert.Nil(t, app.ConvertFrom(hub))
+	assert.NotContains(t, app.Annotations, v1beta1.ConversionDataAnnotation)
+
+	result := &v1beta1.FlinkApplication{}
+	assert.Nil(t, app.ConvertTo(result))
+	assertSemanticEqual(t, hub, result)
+}
+
+func TestV1beta2RoundTrip(t *testing.T) {
+	hub := getV1beta1App()
+	hub.Spec.SavepointInfo = v1beta1.SavepointInfo{}
+	hub.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+
+	app := &FlinkApplication{}
+	assert.Nil(t, app.ConvertFrom(hub))
+	app.Spec.SavepointPath = "s3://savepoints/3"
+
+	intermediate := &v1beta1.FlinkApplication{}
+	assert.Nil(t, app.ConvertTo(intermediate))
+
+	result := &FlinkApplication{}
+	assert.Nil(t, result.ConvertFrom(intermediate))
+	assertSemanticEqual(t, app, result)
+}
+
+func TestChangesToConvertedFieldsAreKept(t *testing.T) {
+	hub := getV1beta1App()
+
+	app := &FlinkApplication{}
+	assert.Nil(t, app.ConvertFrom(hub))
+	app.Spec.SavepointPath = "s3://savepoints/4"
+	app.Spec.DeploymentMode = DeploymentModeBlueGreen
+
+	result := &v1beta1.FlinkApplication{}
+	assert.Nil(t, app.ConvertTo(result))
+	assert.Equal(t, "s3://savepoints/4", result.Spec.SavepointPath)
+	assert.Equal(t, v1beta1.DeploymentModeBlueGreen, result.Spec.DeploymentMode)
+	assert.NotContains(t, result.Annotations, v1beta1.ConversionDataAnnotation)
+}
+
+const fuzzIterations = 200
+
+func getFuzzer(t *testing.T) *fuzz.Fuzzer {
+	seed := time.Now().UnixNano()
+	t.Logf("fuzzer seed: %d", seed)
+
+	return fuzz.New().RandSource(rand.NewSource(seed)).NilChance(.2).NumElements(0, 2).Funcs(
+		// the managed fields of the object meta are recursive, and the conversion only copies the object meta
+		func(meta *metav1.ObjectMeta, c fuzz.Continue) {
+			c.Fuzz(&meta.Name)
+			c.Fuzz(&meta.Namespace)
+			c.Fuzz(&meta.Labels)
+			c.Fuzz(&meta.Annotations)
+		},
+		// the fuzzer cannot fill the interface values of the flink config
+		func(config *FlinkConfig, c fuzz.Continue) {
+			*config = FlinkConfig{}
+			for i := c.Intn(3); i > 0; i-- {
+				(*config)[c.RandString()] = c.RandString()
+			}
+		},
+		func(config *v1beta1.FlinkConfig, c fuzz.Continue) {
+			*config = v1beta1.FlinkConfig{}
+			for i := c.Intn(3); i > 0; i-- {
+				(*config)[c.RandString()] = c.RandString()
+			}
+		},
+		func(mode *DeploymentMode, c fuzz.Continue) {
+			modes := []DeploymentMode{DeploymentModeDual, DeploymentModeBlueGreen}
+			*mode = modes[c.Intn(len(modes))]
+		},
+		func(mode *v1beta1.DeploymentMode, c fuzz.Continue) {
+			modes := []v1beta1.DeploymentMode{"", v1beta1.DeploymentModeSingle, v1beta1.DeploymentModeDual,
+				v1beta1.DeploymentModeBlueGreen}
+			*mode = modes[c.Intn(len(modes))]
+		},
+	)
+}
+
+func TestFuzzV1beta1RoundTrip(t *testing.T) {
+	f := getFuzzer(t)
+	for i := 0; i < fuzzIterations; i++ {
+		hub := &v1beta1.FlinkApplication{}
+		f.Fuzz(hub)
+		// the type meta is set by the caller of the conversion
+		hub.TypeMeta = metav1.TypeMeta{}
+		expected := hub.DeepCopy()
+
+		app := &FlinkApplication{}
+		assert.Nil(t, app.ConvertFrom(hub))
+
+		result := &v1beta1.FlinkApplication{}
+		assert.Nil(t, app.ConvertTo(result))
+		assertSemanticEqual(t, expected, result)
+		assertSemanticEqual(t, expected, hub)
+	}
+}
+
+func TestFuzzV1beta2RoundTrip(t *testing.T) {
+	f := getFuzzer(t)
+	for i := 0; i < fuzzIterations; i++ {
+		app := &FlinkApplication{}
+		f.Fuzz(app)
+		app.TypeMeta = metav1.TypeMeta{}
+		expected := app.DeepCopy()
+
+		hub := &v1beta1.FlinkApplication{}
+		assert.Nil(t, app.ConvertTo(hub))
+
+		result := &FlinkApplication{}
+		assert.Nil(t, result.ConvertFrom(hub))
+		assertSemanticEqual(t, expected, result)
+		assertSemanticEqual(t, expected, app)
+	}
+}
@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1
+// +groupName=flink.k8s.io
+package v1beta2
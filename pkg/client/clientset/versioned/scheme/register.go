@@ -4,6 +4,7 @@ package scheme
 
 import (
 	flinkv1beta1 "github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	flinkv1beta2 "github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -16,6 +17,7 @@ var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
 	flinkv1beta1.AddToScheme,
+	flinkv1beta2.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
@@ -1,9 +1,9 @@
 // Code generated by client-gen. DO NOT EDIT.
 
-package v1beta1
+package v1beta2
 
 import (
-	v1beta1 "github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	v1beta2 "github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta2"
 	"github.com/lyft/flinkk8soperator/pkg/client/clientset/versioned/scheme"
 	serializer "k8s.io/apimachinery/pkg/runtime/serializer"
 	rest "k8s.io/client-go/rest"
@@ -52,7 +52,7 @@ func New(c rest.Interface) *FlinkV1beta2Client {
 }
 
 func setConfigDefaults(config *rest.Config) error {
-	gv := v1beta1.SchemeGroupVersion
+	gv := v1beta2.SchemeGroupVersion
 	config.GroupVersion = &gv
 	config.APIPath = "/apis"
 	config.NegotiatedSerializer = serializer.DirectCodecFactory{CodecFactory: scheme.Codecs}
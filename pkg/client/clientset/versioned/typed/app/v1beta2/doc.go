@@ -1,4 +1,4 @@
 // Code generated by client-gen. DO NOT EDIT.
 
 // This package has the automatically generated typed clients.
-package v1beta1
+package v1beta2
@@ -3,7 +3,7 @@
 package fake
 
 import (
-	v1beta1 "github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	v1beta2 "github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -18,25 +18,25 @@ type FakeFlinkApplications struct {
 	ns   string
 }
 
-var flinkapplicationsResource = schema.GroupVersionResource{Group: "flink.k8s.io", Version: "v1beta1", Resource: "flinkapplications"}
+var flinkapplicationsResource = schema.GroupVersionResource{Group: "flink.k8s.io", Version: "v1beta2", Resource: "flinkapplications"}
 
-var flinkapplicationsKind = schema.GroupVersionKind{Group: "flink.k8s.io", Version: "v1beta1", Kind: "FlinkApplication"}
+var flinkapplicationsKind = schema.GroupVersionKind{Group: "flink.k8s.io", Version: "v1beta2", Kind: "FlinkApplication"}
 
 // Get takes name of the flinkApplication, and returns the corresponding flinkApplication object, and an error if there is any.
-func (c *FakeFlinkApplications) Get(name string, options v1.GetOptions) (result *v1beta1.FlinkApplication, err error) {
+func (c *FakeFlinkApplications) Get(name string, options v1.GetOptions) (result *v1beta2.FlinkApplication, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewGetAction(flinkapplicationsResource, c.ns, name), &v1beta1.FlinkApplication{})
+		Invokes(testing.NewGetAction(flinkapplicationsResource, c.ns, name), &v1beta2.FlinkApplication{})
 
 	if obj == nil {
 		return nil, err
 	}
-	return obj.(*v1beta1.FlinkApplication), err
+	return obj.(*v1beta2.FlinkApplication), err
 }
 
 // List takes label and field selectors, and returns the list of FlinkApplications that match those selectors.
-func (c *FakeFlinkApplications) List(opts v1.ListOptions) (result *v1beta1.FlinkApplicationList, err error) {
+func (c *FakeFlinkApplications) List(opts v1.ListOptions) (result *v1beta2.FlinkApplicationList, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewListAction(flinkapplicationsResource, flinkapplicationsKind, c.ns, opts), &v1beta1.FlinkApplicationList{})
+		Invokes(testing.NewListAction(flinkapplicationsResource, flinkapplicationsKind, c.ns, opts), &v1beta2.FlinkApplicationList{})
 
 	if obj == nil {
 		return nil, err
@@ -46,8 +46,8 @@ func (c *FakeFlinkApplications) List(opts v1.ListOptions) (result *v1beta1.Flink
 	if label == nil {
 		label = labels.Everything()
 	}
-	list := &v1beta1.FlinkApplicationList{ListMeta: obj.(*v1beta1.FlinkApplicationList).ListMeta}
-	for _, item := range obj.(*v1beta1.FlinkApplicationList).Items {
+	list := &v1beta2.FlinkApplicationList{ListMeta: obj.(*v1beta2.FlinkApplicationList).ListMeta}
+	for _, item := range obj.(*v1beta2.FlinkApplicationList).Items {
 		if label.Matches(labels.Set(item.Labels)) {
 			list.Items = append(list.Items, item)
 		}
@@ -63,31 +63,31 @@ func (c *FakeFlinkApplications) Watch(opts v1.ListOptions) (watch.Interface, err
 }
 
 // Create takes the representation of a flinkApplication and creates it.  Returns the server's representation of the flinkApplication, and an error, if there is any.
-func (c *FakeFlinkApplications) Create(flinkApplication *v1beta1.FlinkApplication) (result *v1beta1.FlinkApplication, err error) {
+func (c *FakeFlinkApplications) Create(flinkApplication *v1beta2.FlinkApplication) (result *v1beta2.FlinkApplication, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(flinkapplicationsResource, c.ns, flinkApplication), &v1beta1.FlinkApplication{})
+		Invokes(testing.NewCreateAction(flinkapplicationsResource, c.ns, flinkApplication), &v1beta2.FlinkApplication{})
 
 	if obj == nil {
 		return nil, err
 	}
-	return obj.(*v1beta1.FlinkApplication), err
+	return obj.(*v1beta2.FlinkApplication), err
 }
 
 // Update takes the representation of a flinkApplication and updates it. Returns the server's representation of the flinkApplication, and an error, if there is any.
-func (c *FakeFlinkApplications) Update(flinkApplication *v1beta1.FlinkApplication) (result *v1beta1.FlinkApplication, err error) {
+func (c *FakeFlinkApplications) Update(flinkApplication *v1beta2.FlinkApplication) (result *v1beta2.FlinkApplication, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(flinkapplicationsResource, c.ns, flinkApplication), &v1beta1.FlinkApplication{})
+		Invokes(testing.NewUpdateAction(flinkapplicationsResource, c.ns, flinkApplication), &v1beta2.FlinkApplication{})
 
 	if obj == nil {
 		return nil, err
 	}
-	return obj.(*v1beta1.FlinkApplication), err
+	return obj.(*v1beta2.FlinkApplication), err
 }
 
 // Delete takes name of the flinkApplication and deletes it. Returns an error if one occurs.
 func (c *FakeFlinkApplications) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteAction(flinkapplicationsResource, c.ns, name), &v1beta1.FlinkApplication{})
+		Invokes(testing.NewDeleteAction(flinkapplicationsResource, c.ns, name), &v1beta2.FlinkApplication{})
 
 	return err
 }
@@ -96,17 +96,17 @@ func (c *FakeFlinkApplications) Delete(name string, options *v1.DeleteOptions) e
 func (c *FakeFlinkApplications) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
 	action := testing.NewDeleteCollectionAction(flinkapplicationsResource, c.ns, listOptions)
 
-	_, err := c.Fake.Invokes(action, &v1beta1.FlinkApplicationList{})
+	_, err := c.Fake.Invokes(action, &v1beta2.FlinkApplicationList{})
 	return err
 }
 
 // Patch applies the patch and returns the patched flinkApplication.
-func (c *FakeFlinkApplications) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.FlinkApplication, err error) {
+func (c *FakeFlinkApplications) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta2.FlinkApplication, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(flinkapplicationsResource, c.ns, name, pt, data, subresources...), &v1beta1.FlinkApplication{})
+		Invokes(testing.NewPatchSubresourceAction(flinkapplicationsResource, c.ns, name, pt, data, subresources...), &v1beta2.FlinkApplication{})
 
 	if obj == nil {
 		return nil, err
 	}
-	return obj.(*v1beta1.FlinkApplication), err
+	return obj.(*v1beta2.FlinkApplication), err
 }
@@ -1,11 +1,11 @@
 // Code generated by client-gen. DO NOT EDIT.
 
-package v1beta1
+package v1beta2
 
 import (
 	"time"
 
-	v1beta1 "github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	v1beta2 "github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta2"
 	scheme "github.com/lyft/flinkk8soperator/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -21,14 +21,14 @@ type FlinkApplicationsGetter interface {
 
 // FlinkApplicationInterface has methods to work with FlinkApplication resources.
 type FlinkApplicationInterface interface {
-	Create(*v1beta1.FlinkApplication) (*v1beta1.FlinkApplication, error)
-	Update(*v1beta1.FlinkApplication) (*v1beta1.FlinkApplication, error)
+	Create(*v1beta2.FlinkApplication) (*v1beta2.FlinkApplication, error)
+	Update(*v1beta2.FlinkApplication) (*v1beta2.FlinkApplication, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
-	Get(name string, options v1.GetOptions) (*v1beta1.FlinkApplication, error)
-	List(opts v1.ListOptions) (*v1beta1.FlinkApplicationList, error)
+	Get(name string, options v1.GetOptions) (*v1beta2.FlinkApplication, error)
+	List(opts v1.ListOptions) (*v1beta2.FlinkApplicationList, error)
 	Watch(opts v1.ListOptions) (watch.Interface, error)
-	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.FlinkApplication, err error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta2.FlinkApplication, err error)
 	FlinkApplicationExpansion
 }
 
@@ -47,8 +47,8 @@ func newFlinkApplications(c *FlinkV1beta2Client, namespace string) *flinkApplica
 }
 
 // Get takes name of the flinkApplication, and returns the corresponding flinkApplication object, and an error if there is any.
-func (c *flinkApplications) Get(name string, options v1.GetOptions) (result *v1beta1.FlinkApplication, err error) {
-	result = &v1beta1.FlinkApplication{}
+func (c *flinkApplications) Get(name string, options v1.GetOptions) (result *v1beta2.FlinkApplication, err error) {
+	result = &v1beta2.FlinkApplication{}
 	err = c.client.Get().
 		Namespace(c.ns).
 		Resource("flinkapplications").
@@ -60,12 +60,12 @@ func (c *flinkApplications) Get(name string, options v1.GetOptions) (result *v1b
 }
 
 // List takes label and field selectors, and returns the list of FlinkApplications that match those selectors.
-func (c *flinkApplications) List(opts v1.ListOptions) (result *v1beta1.FlinkApplicationList, err error) {
+func (c *flinkApplications) List(opts v1.ListOptions) (result *v1beta2.FlinkApplicationList, err error) {
 	var timeout time.Duration
 	if opts.TimeoutSeconds != nil {
 		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
 	}
-	result = &v1beta1.FlinkApplicationList{}
+	result = &v1beta2.FlinkApplicationList{}
 	err = c.client.Get().
 		Namespace(c.ns).
 		Resource("flinkapplications").
@@ -92,8 +92,8 @@ func (c *flinkApplications) Watch(opts v1.ListOptions) (watch.Interface, error)
 }
 
 // Create takes the representation of a flinkApplication and creates it.  Returns the server's representation of the flinkApplication, and an error, if there is any.
-func (c *flinkApplications) Create(flinkApplication *v1beta1.FlinkApplication) (result *v1beta1.FlinkApplication, err error) {
-	result = &v1beta1.FlinkApplication{}
+func (c *flinkApplications) Create(flinkApplication *v1beta2.FlinkApplication) (result *v1beta2.FlinkApplication, err error) {
+	result = &v1beta2.FlinkApplication{}
 	err = c.client.Post().
 		Namespace(c.ns).
 		Resource("flinkapplications").
@@ -104,8 +104,8 @@ func (c *flinkApplications) Create(flinkApplication *v1beta1.FlinkApplication) (
 }
 
 // Update takes the representation of a flinkApplication and updates it. Returns the server's representation of the flinkApplication, and an error, if there is any.
-func (c *flinkApplications) Update(flinkApplication *v1beta1.FlinkApplication) (result *v1beta1.FlinkApplication, err error) {
-	result = &v1beta1.FlinkApplication{}
+func (c *flinkApplications) Update(flinkApplication *v1beta2.FlinkApplication) (result *v1beta2.FlinkApplication, err error) {
+	result = &v1beta2.FlinkApplication{}
 	err = c.client.Put().
 		Namespace(c.ns).
 		Resource("flinkapplications").
@@ -144,8 +144,8 @@ func (c *flinkApplications) DeleteCollection(options *v1.DeleteOptions, listOpti
 }
 
 // Patch applies the patch and returns the patched flinkApplication.
-func (c *flinkApplications) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.FlinkApplication, err error) {
-	result = &v1beta1.FlinkApplication{}
+func (c *flinkApplications) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta2.FlinkApplication, err error) {
+	result = &v1beta2.FlinkApplication{}
 	err = c.client.Patch(pt).
 		Namespace(c.ns).
 		Resource("flinkapplications").
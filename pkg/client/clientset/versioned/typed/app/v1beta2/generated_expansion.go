@@ -1,5 +1,5 @@
 // Code generated by client-gen. DO NOT EDIT.
 
-package v1beta1
+package v1beta2
 
 type FlinkApplicationExpansion interface{}
@@ -51,8 +51,20 @@ func GetEnvVar(envs []v1.EnvVar, name string) *v1.EnvVar {
 	return nil
 }
 
+// The objects of a Flink cluster. The task managers run either as the Taskmanager deployment or, if the
+// application configures it, as the TaskmanagerStatefulSet.
 type FlinkDeployment struct {
-	Jobmanager  *appsv1.Deployment
-	Taskmanager *appsv1.Deployment
-	Hash        string
+	Jobmanager             *appsv1.Deployment
+	Taskmanager            *appsv1.Deployment
+	TaskmanagerStatefulSet *appsv1.StatefulSet
+	Hash                   string
+}
+
+// Returns the number of task manager pods, and the number of them that are available. StatefulSets do not report
+// available replicas, so their ready replicas are counted instead.
+func (d *FlinkDeployment) TaskManagerStatus() (replicas int32, available int32) {
+	if d.TaskmanagerStatefulSet != nil {
+		return d.TaskmanagerStatefulSet.Status.Replicas, d.TaskmanagerStatefulSet.Status.ReadyReplicas
+	}
+	return d.Taskmanager.Status.Replicas, d.Taskmanager.Status.AvailableReplicas
 }
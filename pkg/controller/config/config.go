@@ -24,6 +24,17 @@ type Config struct {
 	BaseBackoffDuration   config.Duration `json:"baseBackoffDuration" pflag:"\"100ms\",Determines the base backoff for exponential retries."`
 	MaxBackoffDuration    config.Duration `json:"maxBackoffDuration" pflag:"\"30s\",Determines the max backoff for exponential retries."`
 	MaxErrDuration        config.Duration `json:"maxErrDuration" pflag:"\"5m\",Determines the max time to wait on errors."`
+	MaxTaskManagers       int             `json:"maxTaskManagers" pflag:",Maximum number of task managers per application. Applications whose parallelism cannot be satisfied are rejected. 0 disables the check."`
+	EnableWebhooks        bool            `json:"enableWebhooks" pflag:",Serves the admission webhooks for FlinkApplication resources."`
+	WebhookPort           int             `json:"webhookPort" pflag:"9443,Port at which the webhook server listens."`
+	WebhookCertDir        string          `json:"webhookCertDir" pflag:"\"/etc/flinkoperator/webhook-certs\",Directory containing tls.crt and tls.key for the webhook server."`
+	S3Endpoint            string          `json:"s3Endpoint" pflag:"\"https://s3.amazonaws.com\",Endpoint used to download s3:// job jars."`
+	JarURIAllowlist       []string        `json:"jarUriAllowlist" pflag:",Hosts and buckets job jars may be downloaded from, as scheme://host entries (e.g. https://artifacts.example.com or s3://bucket). Downloading jars is disabled if empty."`
+	LeaderElection        bool            `json:"leaderElection" pflag:",Elects a leader among the replicas of the operator, so that only one of them reconciles applications."`
+	LeaseNamespace        string          `json:"leaseNamespace" pflag:",Namespace of the leader election lock. Defaults to the namespace the operator runs in."`
+	LeaseDuration         config.Duration `json:"leaseDuration" pflag:"\"15s\",Duration that standby replicas wait before taking over leadership from a leader that stopped renewing it."`
+	RenewDeadline         config.Duration `json:"renewDeadline" pflag:"\"10s\",Duration that the leader retries renewing leadership for before giving it up."`
+	StuckReconcileTimeout config.Duration `json:"stuckReconcileTimeout" pflag:"\"10m\",Duration after which a reconcile loop that makes no progress fails the liveness check."`
 }
 
 func GetConfig() *Config {
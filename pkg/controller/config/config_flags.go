@@ -53,5 +53,16 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "baseBackoffDuration"), "100ms", "Determines the base backoff for exponential retries.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "maxBackoffDuration"), "30s", "Determines the max backoff for exponential retries.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "maxErrDuration"), "5m", "Determines the max time to wait on errors.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "maxTaskManagers"), *new(int), "Maximum number of task managers per application. Applications whose parallelism cannot be satisfied are rejected. 0 disables the check.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "enableWebhooks"), *new(bool), "Serves the admission webhooks for FlinkApplication resources.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "webhookPort"), 9443, "Port at which the webhook server listens.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "webhookCertDir"), "/etc/flinkoperator/webhook-certs", "Directory containing tls.crt and tls.key for the webhook server.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "s3Endpoint"), "https://s3.amazonaws.com", "Endpoint used to download s3:// job jars.")
+	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "jarUriAllowlist"), []string{}, "Hosts and buckets job jars may be downloaded from, as scheme://host entries (e.g. https://artifacts.example.com or s3://bucket). Downloading jars is disabled if empty.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "leaderElection"), *new(bool), "Elects a leader among the replicas of the operator, so that only one of them reconciles applications.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "leaseNamespace"), *new(string), "Namespace of the leader election lock. Defaults to the namespace the operator runs in.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "leaseDuration"), "15s", "Duration that standby replicas wait before taking over leadership from a leader that stopped renewing it.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "renewDeadline"), "10s", "Duration that the leader retries renewing leadership for before giving it up.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "stuckReconcileTimeout"), "10m", "Duration after which a reconcile loop that makes no progress fails the liveness check.")
 	return cmdFlags
 }
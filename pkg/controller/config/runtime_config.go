@@ -1,9 +1,12 @@
 package config
 
 import (
+	"github.com/lyft/flinkk8soperator/pkg/controller/health"
 	"github.com/lyft/flytestdlib/promutils"
 )
 
 type RuntimeConfig struct {
 	MetricsScope promutils.Scope
+	// Records the progress of the reconcile loop, if set
+	Health *health.Status
 }
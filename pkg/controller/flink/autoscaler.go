@@ -0,0 +1,211 @@
+package flink
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flytestdlib/logger"
+)
+
+const busyTimeMetric = "busyTimeMsPerSecond"
+const backPressuredTimeMetric = "backPressuredTimeMsPerSecond"
+
+// The consumer lag metrics of the legacy FlinkKafkaConsumer and of the KafkaSource respectively. These are registered
+// in the scope of the source operator, so only the suffix of the metric ID is known in advance.
+var kafkaLagMetricSuffixes = []string{".records-lag-max", ".pendingRecords"}
+
+// Changes in utilization within this fraction of the target do not cause the job to be rescaled
+const autoscalerTolerance = 0.1
+
+// The value of the AutoscaledParallelism annotation, which the state machine sets on the application when the
+// autoscaler rescales the job. It is kept on the resource rather than in the status, so that the hash of the
+// application only depends on the resource.
+type autoscaledParallelism struct {
+	Parallelism int32 `json:"parallelism"`
+	// The spec.parallelism at the time of the choice. A change to spec.parallelism discards the chosen parallelism.
+	SpecParallelism int32 `json:"specParallelism"`
+}
+
+func getAutoscaledParallelism(app *v1beta1.FlinkApplication) (autoscaledParallelism, bool) {
+	var result autoscaledParallelism
+	value, ok := app.Annotations[AutoscaledParallelism]
+	if !ok || json.Unmarshal([]byte(value), &result) != nil || result.Parallelism <= 0 {
+		return result, false
+	}
+	return result, true
+}
+
+// Pins the parallelism chosen by the autoscaler in the AutoscaledParallelism annotation of the application, or removes
+// the annotation if the parallelism is 0. The caller needs to update the resource.
+func SetAutoscaledParallelism(app *v1beta1.FlinkApplication, parallelism int32) {
+	if parallelism == 0 {
+		delete(app.Annotations, AutoscaledParallelism)
+		return
+	}
+
+	value, _ := json.Marshal(autoscaledParallelism{
+		Parallelism:     parallelism,
+		SpecParallelism: app.Spec.Parallelism,
+	})
+	if app.Annotations == nil {
+		app.Annotations = map[string]string{}
+	}
+	app.Annotations[AutoscaledParallelism] = string(value)
+}
+
+// Returns whether the application carries a parallelism chosen by the autoscaler for an earlier spec.parallelism
+func HasStaleAutoscaledParallelism(app *v1beta1.FlinkApplication) bool {
+	pinned, ok := getAutoscaledParallelism(app)
+	return ok && pinned.SpecParallelism != app.Spec.Parallelism
+}
+
+// Returns the parallelism of the job for the current version of the application. Once the autoscaler has chosen a
+// parallelism, it takes precedence over spec.parallelism (within the currently configured bounds) until
+// spec.parallelism is changed.
+func GetParallelism(app *v1beta1.FlinkApplication) int32 {
+	autoscaler := app.Spec.Autoscaler
+	pinned, ok := getAutoscaledParallelism(app)
+	if autoscaler == nil || !ok || pinned.SpecParallelism != app.Spec.Parallelism {
+		return app.Spec.Parallelism
+	}
+
+	return clampParallelism(pinned.Parallelism, autoscaler)
+}
+
+func clampParallelism(parallelism int32, autoscaler *v1beta1.AutoscalerConfig) int32 {
+	if parallelism < autoscaler.MinParallelism {
+		return autoscaler.MinParallelism
+	}
+	if autoscaler.MaxParallelism > 0 && parallelism > autoscaler.MaxParallelism {
+		return autoscaler.MaxParallelism
+	}
+	return parallelism
+}
+
+func GetAutoscalerTargetUtilization(autoscaler *v1beta1.AutoscalerConfig) float64 {
+	if autoscaler.TargetUtilization == nil {
+		return v1beta1.DefaultAutoscalerTargetUtilization
+	}
+	return *autoscaler.TargetUtilization
+}
+
+func getAutoscalerMetrics(autoscaler *v1beta1.AutoscalerConfig) []v1beta1.AutoscalerMetric {
+	if len(autoscaler.Metrics) == 0 {
+		return v1beta1.DefaultAutoscalerMetrics
+	}
+	return autoscaler.Metrics
+}
+
+func GetAutoscalerCooldown(autoscaler *v1beta1.AutoscalerConfig) time.Duration {
+	if autoscaler.Cooldown == nil {
+		return v1beta1.DefaultAutoscalerCooldown
+	}
+	return autoscaler.Cooldown.Duration
+}
+
+// Computes the parallelism that brings the utilization of the job to the target utilization, within the configured
+// bounds. Returns the current parallelism if the utilization is close enough to the target.
+func ComputeAutoscaledParallelism(app *v1beta1.FlinkApplication, utilization float64) int32 {
+	current := GetParallelism(app)
+	ratio := utilization / GetAutoscalerTargetUtilization(app.Spec.Autoscaler)
+	if math.IsNaN(ratio) || math.IsInf(ratio, 0) || math.Abs(ratio-1) <= autoscalerTolerance {
+		return current
+	}
+
+	desired := int32(math.Ceil(float64(current) * ratio))
+	if desired < 1 {
+		desired = 1
+	}
+	return clampParallelism(desired, app.Spec.Autoscaler)
+}
+
+func isKafkaLagMetric(id string) bool {
+	for _, suffix := range kafkaLagMetricSuffixes {
+		if strings.HasSuffix(id, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Fetches the metrics of the vertices of the current job, and returns the configured autoscaler metric with the
+// highest utilization, along with that utilization. The metric is empty if none of the metrics are reported.
+func (f *Controller) GetAutoscalerUtilization(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (v1beta1.AutoscalerMetric, float64, error) {
+	autoscaler := application.Spec.Autoscaler
+	job, err := f.GetJobForApplication(ctx, application, hash)
+	if err != nil || job == nil {
+		return "", 0, err
+	}
+
+	url := f.getURLFromApp(application, hash)
+	utilization := map[v1beta1.AutoscalerMetric]float64{}
+	kafkaLag := 0.0
+	hasKafkaLag := false
+	for _, vertex := range job.Vertices {
+		var metricIDs []string
+		for _, metric := range getAutoscalerMetrics(autoscaler) {
+			switch metric {
+			case v1beta1.AutoscalerMetricBusyTime:
+				metricIDs = append(metricIDs, busyTimeMetric)
+			case v1beta1.AutoscalerMetricBackpressure:
+				metricIDs = append(metricIDs, backPressuredTimeMetric)
+			case v1beta1.AutoscalerMetricKafkaLag:
+				available, err := f.flinkClient.GetJobVertexMetrics(ctx, url, job.JobID, vertex.ID, nil)
+				if err != nil {
+					return "", 0, err
+				}
+				for _, m := range available {
+					if isKafkaLagMetric(m.ID) {
+						metricIDs = append(metricIDs, m.ID)
+					}
+				}
+			}
+		}
+		if len(metricIDs) == 0 {
+			continue
+		}
+
+		vertexMetrics, err := f.flinkClient.GetJobVertexMetrics(ctx, url, job.JobID, vertex.ID, metricIDs)
+		if err != nil {
+			return "", 0, err
+		}
+
+		for _, m := range vertexMetrics {
+			switch {
+			case m.ID == busyTimeMetric:
+				utilization[v1beta1.AutoscalerMetricBusyTime] = math.Max(
+					utilization[v1beta1.AutoscalerMetricBusyTime], m.Avg/1000)
+			case m.ID == backPressuredTimeMetric:
+				utilization[v1beta1.AutoscalerMetricBackpressure] = math.Max(
+					utilization[v1beta1.AutoscalerMetricBackpressure], m.Avg/1000)
+			case isKafkaLagMetric(m.ID):
+				kafkaLag += m.Sum
+				hasKafkaLag = true
+			}
+		}
+	}
+
+	if hasKafkaLag && autoscaler.MaxKafkaLag != nil && *autoscaler.MaxKafkaLag > 0 {
+		utilization[v1beta1.AutoscalerMetricKafkaLag] = kafkaLag / float64(*autoscaler.MaxKafkaLag)
+	}
+
+	var maxMetric v1beta1.AutoscalerMetric
+	maxUtilization := 0.0
+	for _, metric := range getAutoscalerMetrics(autoscaler) {
+		value, ok := utilization[metric]
+		if !ok {
+			logger.Warnf(ctx, "Metric %s is not reported by job %s", metric, job.JobID)
+			continue
+		}
+		if maxMetric == "" || value > maxUtilization {
+			maxMetric = metric
+			maxUtilization = value
+		}
+	}
+
+	return maxMetric, maxUtilization, nil
+}
@@ -0,0 +1,145 @@
+package flink
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/flink/client"
+	clientMock "github.com/lyft/flinkk8soperator/pkg/controller/flink/client/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func getAutoscalerTestApp() v1beta1.FlinkApplication {
+	app := getFlinkTestApp()
+	target := 0.5
+	app.Spec.Autoscaler = &v1beta1.AutoscalerConfig{
+		MinParallelism:    2,
+		MaxParallelism:    16,
+		TargetUtilization: &target,
+	}
+	return app
+}
+
+func TestGetParallelism(t *testing.T) {
+	app := getFlinkTestApp()
+	SetAutoscaledParallelism(&app, 12)
+	// the annotation is ignored unless the autoscaler is enabled
+	assert.Equal(t, int32(8), GetParallelism(&app))
+
+	app = getAutoscalerTestApp()
+	assert.Equal(t, int32(8), GetParallelism(&app))
+
+	// the status is informational only
+	app.Status.Autoscaler = &v1beta1.AutoscalerStatus{Parallelism: 12, SpecParallelism: 8}
+	assert.Equal(t, int32(8), GetParallelism(&app))
+
+	SetAutoscaledParallelism(&app, 12)
+	assert.Equal(t, int32(12), GetParallelism(&app))
+	assert.False(t, HasStaleAutoscaledParallelism(&app))
+
+	// the bounds apply to earlier decisions as well
+	app.Spec.Autoscaler.MaxParallelism = 10
+	assert.Equal(t, int32(10), GetParallelism(&app))
+
+	// changing spec.parallelism discards the decision
+	app.Spec.Parallelism = 6
+	assert.Equal(t, int32(6), GetParallelism(&app))
+	assert.True(t, HasStaleAutoscaledParallelism(&app))
+
+	SetAutoscaledParallelism(&app, 0)
+	_, ok := app.Annotations[AutoscaledParallelism]
+	assert.False(t, ok)
+
+	app.Annotations = map[string]string{AutoscaledParallelism: "invalid"}
+	assert.Equal(t, int32(6), GetParallelism(&app))
+}
+
+func TestAutoscaledParallelismChangesHash(t *testing.T) {
+	app := getAutoscalerTestApp()
+	slots := int32(4)
+	app.Spec.TaskManagerConfig.TaskSlots = &slots
+	hash := HashForApplication(&app)
+
+	// the hash does not depend on the status
+	app.Status.Autoscaler = &v1beta1.AutoscalerStatus{Parallelism: 12, SpecParallelism: 8}
+	assert.Equal(t, hash, HashForApplication(&app))
+
+	SetAutoscaledParallelism(&app, 12)
+	assert.NotEqual(t, hash, HashForApplication(&app))
+	assert.Equal(t, int32(3), computeTaskManagerReplicas(&app))
+
+	// an annotation that does not apply does not change the hash
+	SetAutoscaledParallelism(&app, 8)
+	assert.Equal(t, hash, HashForApplication(&app))
+}
+
+func TestComputeAutoscaledParallelism(t *testing.T) {
+	app := getAutoscalerTestApp()
+
+	// within the tolerance of the target
+	assert.Equal(t, int32(8), ComputeAutoscaledParallelism(&app, 0.53))
+
+	assert.Equal(t, int32(12), ComputeAutoscaledParallelism(&app, 0.75))
+	assert.Equal(t, int32(4), ComputeAutoscaledParallelism(&app, 0.25))
+
+	// limited by the bounds
+	assert.Equal(t, int32(16), ComputeAutoscaledParallelism(&app, 1.5))
+	assert.Equal(t, int32(2), ComputeAutoscaledParallelism(&app, 0))
+}
+
+func TestGetAutoscalerUtilization(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getAutoscalerTestApp()
+	maxLag := int64(1000)
+	app.Spec.Autoscaler.MaxKafkaLag = &maxLag
+	app.Spec.Autoscaler.Metrics = []v1beta1.AutoscalerMetric{v1beta1.AutoscalerMetricBusyTime,
+		v1beta1.AutoscalerMetricBackpressure, v1beta1.AutoscalerMetricKafkaLag}
+
+	mockJmClient := flinkControllerForTest.flinkClient.(*clientMock.JobManagerClient)
+	mockJmClient.GetJobOverviewFunc = func(ctx context.Context, url string, jobID string) (*client.FlinkJobOverview, error) {
+		return &client.FlinkJobOverview{
+			JobID:    testJobID,
+			State:    client.Running,
+			Vertices: []client.FlinkJobVertex{{ID: "source"}, {ID: "sink"}},
+		}, nil
+	}
+	mockJmClient.GetJobVertexMetricsFunc = func(ctx context.Context, url string, jobID string, vertexID string, metrics []string) ([]client.JobVertexMetric, error) {
+		assert.Equal(t, testJobID, jobID)
+		if len(metrics) == 0 {
+			if vertexID == "source" {
+				return []client.JobVertexMetric{{ID: "numRecordsOut"}, {ID: "Source__kafka.KafkaConsumer.records-lag-max"}}, nil
+			}
+			return []client.JobVertexMetric{{ID: "numRecordsIn"}}, nil
+		}
+
+		if vertexID == "source" {
+			assert.Equal(t, []string{busyTimeMetric, backPressuredTimeMetric,
+				"Source__kafka.KafkaConsumer.records-lag-max"}, metrics)
+			return []client.JobVertexMetric{
+				{ID: busyTimeMetric, Avg: 200},
+				{ID: backPressuredTimeMetric, Avg: 600},
+				{ID: "Source__kafka.KafkaConsumer.records-lag-max", Sum: 500},
+			}, nil
+		}
+		assert.Equal(t, []string{busyTimeMetric, backPressuredTimeMetric}, metrics)
+		return []client.JobVertexMetric{
+			{ID: busyTimeMetric, Avg: 900},
+			{ID: backPressuredTimeMetric, Avg: 0},
+		}, nil
+	}
+
+	metric, utilization, err := flinkControllerForTest.GetAutoscalerUtilization(context.Background(), &app, "hash")
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.AutoscalerMetricBusyTime, metric)
+	assert.Equal(t, 0.9, utilization)
+
+	// without any of the configured metrics there is nothing to base a decision on
+	app.Spec.Autoscaler.Metrics = []v1beta1.AutoscalerMetric{v1beta1.AutoscalerMetricKafkaLag}
+	mockJmClient.GetJobVertexMetricsFunc = func(ctx context.Context, url string, jobID string, vertexID string, metrics []string) ([]client.JobVertexMetric, error) {
+		return []client.JobVertexMetric{{ID: "numRecordsIn"}}, nil
+	}
+	metric, _, err = flinkControllerForTest.GetAutoscalerUtilization(context.Background(), &app, "hash")
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.AutoscalerMetric(""), metric)
+}
@@ -0,0 +1,19 @@
+package flink
+
+import (
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/flink/client"
+)
+
+// Returns how long the cluster of a bounded job is kept after the job has finished or failed
+func GetJobTTLAfterFinished(config *v1beta1.BoundedJobConfig) time.Duration {
+	return durationOrDefault(config.TTLAfterFinished, v1beta1.DefaultBoundedJobTTLAfterFinished)
+}
+
+// Returns whether the application runs a bounded job, and the given job has finished or failed. Unlike other jobs,
+// such a job is not restarted.
+func IsBoundedJobDone(app *v1beta1.FlinkApplication, job *client.FlinkJobOverview) bool {
+	return app.Spec.BoundedJob != nil && job != nil && (job.State == client.Finished || job.State == client.Failed)
+}
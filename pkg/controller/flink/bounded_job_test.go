@@ -0,0 +1,34 @@
+package flink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/flink/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetJobTTLAfterFinished(t *testing.T) {
+	config := v1beta1.BoundedJobConfig{}
+	assert.Equal(t, v1beta1.DefaultBoundedJobTTLAfterFinished, GetJobTTLAfterFinished(&config))
+
+	config.TTLAfterFinished = &metav1.Duration{Duration: time.Hour}
+	assert.Equal(t, time.Hour, GetJobTTLAfterFinished(&config))
+}
+
+func TestIsBoundedJobDone(t *testing.T) {
+	app := getFlinkTestApp()
+	job := client.FlinkJobOverview{State: client.Finished}
+	// other jobs are restarted when they finish
+	assert.False(t, IsBoundedJobDone(&app, &job))
+
+	app.Spec.BoundedJob = &v1beta1.BoundedJobConfig{}
+	assert.True(t, IsBoundedJobDone(&app, &job))
+	job.State = client.Failed
+	assert.True(t, IsBoundedJobDone(&app, &job))
+	job.State = client.Running
+	assert.False(t, IsBoundedJobDone(&app, &job))
+	assert.False(t, IsBoundedJobDone(&app, nil))
+}
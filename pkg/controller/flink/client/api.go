@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	neturl "net/url"
+	"path"
 	"strings"
 	"time"
 
@@ -24,6 +27,7 @@ const GetClusterOverviewURL = "/overview"
 const WebUIAnchor = "/#"
 
 const submitJobURL = "/jars/%s/run"
+const uploadJarURL = "/jars/upload"
 const savepointURL = "/jobs/%s/savepoints"
 const jobURL = "/jobs/%s"
 const checkSavepointStatusURL = "/jobs/%s/savepoints/%s"
@@ -31,6 +35,7 @@ const getJobsURL = "/jobs"
 const getJobConfigURL = "/jobs/%s/config"
 const checkpointsURL = "/jobs/%s/checkpoints"
 const taskmanagersURL = "/taskmanagers"
+const jobVertexMetricsURL = "/jobs/%s/vertices/%s/subtasks/metrics"
 const httpGet = "GET"
 const httpPost = "POST"
 const httpPatch = "PATCH"
@@ -51,6 +56,7 @@ type FlinkAPIInterface interface {
 	SavepointJob(ctx context.Context, url string, jobID string) (string, error)
 	ForceCancelJob(ctx context.Context, url string, jobID string) error
 	SubmitJob(ctx context.Context, url string, jarID string, submitJobRequest SubmitJobRequest) (*SubmitJobResponse, error)
+	UploadJar(ctx context.Context, url string, fileName string, jar io.Reader) (*UploadJarResponse, error)
 	CheckSavepointStatus(ctx context.Context, url string, jobID, triggerID string) (*SavepointResponse, error)
 	GetJobs(ctx context.Context, url string) (*GetJobsResponse, error)
 	GetClusterOverview(ctx context.Context, url string) (*ClusterOverviewResponse, error)
@@ -59,6 +65,7 @@ type FlinkAPIInterface interface {
 	GetTaskManagers(ctx context.Context, url string) (*TaskManagersResponse, error)
 	GetCheckpointCounts(ctx context.Context, url string, jobID string) (*CheckpointResponse, error)
 	GetJobOverview(ctx context.Context, url string, jobID string) (*FlinkJobOverview, error)
+	GetJobVertexMetrics(ctx context.Context, url string, jobID string, vertexID string, metrics []string) ([]JobVertexMetric, error)
 }
 
 type FlinkJobManagerClient struct {
@@ -66,49 +73,57 @@ type FlinkJobManagerClient struct {
 }
 
 type flinkJobManagerClientMetrics struct {
-	scope                        promutils.Scope
-	submitJobSuccessCounter      labeled.Counter
-	submitJobFailureCounter      labeled.Counter
-	cancelJobSuccessCounter      labeled.Counter
-	cancelJobFailureCounter      labeled.Counter
-	forceCancelJobSuccessCounter labeled.Counter
-	forceCancelJobFailureCounter labeled.Counter
-	checkSavepointSuccessCounter labeled.Counter
-	checkSavepointFailureCounter labeled.Counter
-	getJobsSuccessCounter        labeled.Counter
-	getJobsFailureCounter        labeled.Counter
-	getJobConfigSuccessCounter   labeled.Counter
-	getJobConfigFailureCounter   labeled.Counter
-	getClusterSuccessCounter     labeled.Counter
-	getClusterFailureCounter     labeled.Counter
-	getCheckpointsSuccessCounter labeled.Counter
-	getCheckpointsFailureCounter labeled.Counter
-	savepointJobSuccessCounter   labeled.Counter
-	savepointJobFailureCounter   labeled.Counter
+	scope                          promutils.Scope
+	submitJobSuccessCounter        labeled.Counter
+	submitJobFailureCounter        labeled.Counter
+	cancelJobSuccessCounter        labeled.Counter
+	cancelJobFailureCounter        labeled.Counter
+	forceCancelJobSuccessCounter   labeled.Counter
+	forceCancelJobFailureCounter   labeled.Counter
+	checkSavepointSuccessCounter   labeled.Counter
+	checkSavepointFailureCounter   labeled.Counter
+	getJobsSuccessCounter          labeled.Counter
+	getJobsFailureCounter          labeled.Counter
+	getJobConfigSuccessCounter     labeled.Counter
+	getJobConfigFailureCounter     labeled.Counter
+	getClusterSuccessCounter       labeled.Counter
+	getClusterFailureCounter       labeled.Counter
+	getCheckpointsSuccessCounter   labeled.Counter
+	getCheckpointsFailureCounter   labeled.Counter
+	savepointJobSuccessCounter     labeled.Counter
+	savepointJobFailureCounter     labeled.Counter
+	getVertexMetricsSuccessCounter labeled.Counter
+	getVertexMetricsFailureCounter labeled.Counter
+	uploadJarSuccessCounter        labeled.Counter
+	uploadJarFailureCounter        labeled.Counter
 }
 
 func newFlinkJobManagerClientMetrics(scope promutils.Scope) *flinkJobManagerClientMetrics {
 	flinkJmClientScope := scope.NewSubScope("flink_jm_client")
 	return &flinkJobManagerClientMetrics{
-		scope:                        scope,
-		submitJobSuccessCounter:      labeled.NewCounter("submit_job_success", "Flink job submission successful", flinkJmClientScope),
-		submitJobFailureCounter:      labeled.NewCounter("submit_job_failure", "Flink job submission failed", flinkJmClientScope),
-		cancelJobSuccessCounter:      labeled.NewCounter("cancel_job_success", "Flink job cancellation successful", flinkJmClientScope),
-		cancelJobFailureCounter:      labeled.NewCounter("cancel_job_failure", "Flink job cancellation failed", flinkJmClientScope),
-		forceCancelJobSuccessCounter: labeled.NewCounter("force_cancel_job_success", "Flink forced job cancellation successful", flinkJmClientScope),
-		forceCancelJobFailureCounter: labeled.NewCounter("force_cancel_job_failure", "Flink forced job cancellation failed", flinkJmClientScope),
-		checkSavepointSuccessCounter: labeled.NewCounter("check_savepoint_status_success", "Flink check savepoint status successful", flinkJmClientScope),
-		checkSavepointFailureCounter: labeled.NewCounter("check_savepoint_status_failure", "Flink check savepoint status failed", flinkJmClientScope),
-		getJobsSuccessCounter:        labeled.NewCounter("get_jobs_success", "Get flink jobs succeeded", flinkJmClientScope),
-		getJobsFailureCounter:        labeled.NewCounter("get_jobs_failure", "Get flink jobs failed", flinkJmClientScope),
-		getJobConfigSuccessCounter:   labeled.NewCounter("get_job_config_success", "Get flink job config succeeded", flinkJmClientScope),
-		getJobConfigFailureCounter:   labeled.NewCounter("get_job_config_failure", "Get flink job config failed", flinkJmClientScope),
-		getClusterSuccessCounter:     labeled.NewCounter("get_cluster_success", "Get cluster overview succeeded", flinkJmClientScope),
-		getClusterFailureCounter:     labeled.NewCounter("get_cluster_failure", "Get cluster overview failed", flinkJmClientScope),
-		getCheckpointsSuccessCounter: labeled.NewCounter("get_checkpoints_success", "Get checkpoint request succeeded", flinkJmClientScope),
-		getCheckpointsFailureCounter: labeled.NewCounter("get_checkpoints_failed", "Get checkpoint request failed", flinkJmClientScope),
-		savepointJobSuccessCounter:   labeled.NewCounter("savepoint_job_success", "Savepoint job request succeeded", flinkJmClientScope),
-		savepointJobFailureCounter:   labeled.NewCounter("savepoint_job_failed", "Savepoint job request failed", flinkJmClientScope),
+		scope:                          scope,
+		submitJobSuccessCounter:        labeled.NewCounter("submit_job_success", "Flink job submission successful", flinkJmClientScope),
+		submitJobFailureCounter:        labeled.NewCounter("submit_job_failure", "Flink job submission failed", flinkJmClientScope),
+		cancelJobSuccessCounter:        labeled.NewCounter("cancel_job_success", "Flink job cancellation successful", flinkJmClientScope),
+		cancelJobFailureCounter:        labeled.NewCounter("cancel_job_failure", "Flink job cancellation failed", flinkJmClientScope),
+		forceCancelJobSuccessCounter:   labeled.NewCounter("force_cancel_job_success", "Flink forced job cancellation successful", flinkJmClientScope),
+		forceCancelJobFailureCounter:   labeled.NewCounter("force_cancel_job_failure", "Flink forced job cancellation failed", flinkJmClientScope),
+		checkSavepointSuccessCounter:   labeled.NewCounter("check_savepoint_status_success", "Flink check savepoint status successful", flinkJmClientScope),
+		checkSavepointFailureCounter:   labeled.NewCounter("check_savepoint_status_failure", "Flink check savepoint status failed", flinkJmClientScope),
+		getJobsSuccessCounter:          labeled.NewCounter("get_jobs_success", "Get flink jobs succeeded", flinkJmClientScope),
+		getJobsFailureCounter:          labeled.NewCounter("get_jobs_failure", "Get flink jobs failed", flinkJmClientScope),
+		getJobConfigSuccessCounter:     labeled.NewCounter("get_job_config_success", "Get flink job config succeeded", flinkJmClientScope),
+		getJobConfigFailureCounter:     labeled.NewCounter("get_job_config_failure", "Get flink job config failed", flinkJmClientScope),
+		getClusterSuccessCounter:       labeled.NewCounter("get_cluster_success", "Get cluster overview succeeded", flinkJmClientScope),
+		getClusterFailureCounter:       labeled.NewCounter("get_cluster_failure", "Get cluster overview failed", flinkJmClientScope),
+		getCheckpointsSuccessCounter:   labeled.NewCounter("get_checkpoints_success", "Get checkpoint request succeeded", flinkJmClientScope),
+		getCheckpointsFailureCounter:   labeled.NewCounter("get_checkpoints_failed", "Get checkpoint request failed", flinkJmClientScope),
+		savepointJobSuccessCounter:     labeled.NewCounter("savepoint_job_success", "Savepoint job request succeeded", flinkJmClientScope),
+		savepointJobFailureCounter:     labeled.NewCounter("savepoint_job_failed", "Savepoint job request failed", flinkJmClientScope),
+		getVertexMetricsSuccessCounter: labeled.NewCounter("get_vertex_metrics_success", "Get job vertex metrics succeeded", flinkJmClientScope),
+		getVertexMetricsFailureCounter: labeled.NewCounter("get_vertex_metrics_failed", "Get job vertex metrics failed", flinkJmClientScope),
+		uploadJarSuccessCounter:        labeled.NewCounter("upload_jar_success", "Flink jar upload successful", flinkJmClientScope),
+		uploadJarFailureCounter:        labeled.NewCounter("upload_jar_failure", "Flink jar upload failed", flinkJmClientScope),
 	}
 }
 
@@ -263,6 +278,38 @@ func (c *FlinkJobManagerClient) SubmitJob(ctx context.Context, url string, jarID
 	return &submitJobResponse, nil
 }
 
+// Uploads a jar to the JobManager, which stores it under a generated name. The jar ID in the response is used to
+// submit jobs from the jar.
+func (c *FlinkJobManagerClient) UploadJar(ctx context.Context, url string, fileName string, jar io.Reader) (*UploadJarResponse, error) {
+	url = url + uploadJarURL
+	client := resty.New().SetLogger(logger.GetLogWriter(ctx)).SetTimeout(defaultTimeOut)
+	response, err := client.R().
+		SetFileReader("jarfile", fileName, jar).
+		Post(url)
+	if err != nil {
+		c.metrics.uploadJarFailureCounter.Inc(ctx)
+		return nil, GetRetryableError(err, v1beta1.UploadJar, GlobalFailure, DefaultRetries)
+	}
+	if response != nil && !response.IsSuccess() {
+		c.metrics.uploadJarFailureCounter.Inc(ctx)
+		logger.Errorf(ctx, fmt.Sprintf("Jar upload failed with response %v", response))
+		return nil, GetRetryableErrorWithMessage(err, v1beta1.UploadJar, response.Status(), DefaultRetries,
+			string(response.Body()))
+	}
+
+	var uploadJarResponse UploadJarResponse
+	if err = json.Unmarshal(response.Body(), &uploadJarResponse); err != nil || uploadJarResponse.FileName == "" {
+		logger.Errorf(ctx, "Unable to Unmarshal uploadJarResponse %v, err: %v", response, err)
+		return nil, GetRetryableError(errors.New("invalid jar upload response"), v1beta1.UploadJar,
+			JSONUnmarshalError, DefaultRetries)
+	}
+	// the jar ID is the name the jar is stored under, without the directory
+	uploadJarResponse.JarID = path.Base(uploadJarResponse.FileName)
+
+	c.metrics.uploadJarSuccessCounter.Inc(ctx)
+	return &uploadJarResponse, nil
+}
+
 func (c *FlinkJobManagerClient) CheckSavepointStatus(ctx context.Context, url string, jobID, triggerID string) (*SavepointResponse, error) {
 	path := fmt.Sprintf(checkSavepointStatusURL, jobID, triggerID)
 	url = url + path
@@ -389,6 +436,36 @@ func (c *FlinkJobManagerClient) GetJobOverview(ctx context.Context, url string,
 	return &jobOverviewResponse, nil
 }
 
+// Returns the given metrics of the vertex, aggregated over its subtasks. If no metrics are given, the response only
+// contains the IDs of the available metrics.
+func (c *FlinkJobManagerClient) GetJobVertexMetrics(ctx context.Context, url string, jobID string, vertexID string,
+	metrics []string) ([]JobVertexMetric, error) {
+	endpoint := url + fmt.Sprintf(jobVertexMetricsURL, jobID, vertexID)
+	if len(metrics) > 0 {
+		endpoint = endpoint + "?get=" + neturl.QueryEscape(strings.Join(metrics, ","))
+	}
+
+	response, err := c.executeRequest(ctx, httpGet, endpoint, nil)
+	if err != nil {
+		c.metrics.getVertexMetricsFailureCounter.Inc(ctx)
+		return nil, GetRetryableError(err, v1beta1.GetJobVertexMetrics, GlobalFailure, DefaultRetries)
+	}
+	if response != nil && !response.IsSuccess() {
+		c.metrics.getVertexMetricsFailureCounter.Inc(ctx)
+		logger.Errorf(ctx, fmt.Sprintf("Get job vertex metrics failed with response %v", response))
+		return nil, GetRetryableError(err, v1beta1.GetJobVertexMetrics, response.Status(), DefaultRetries)
+	}
+
+	var vertexMetrics []JobVertexMetric
+	if err = json.Unmarshal(response.Body(), &vertexMetrics); err != nil {
+		logger.Errorf(ctx, "Unable to Unmarshal job vertex metrics %v, err: %v", response, err)
+		return nil, GetRetryableError(err, v1beta1.GetJobVertexMetrics, JSONUnmarshalError, DefaultRetries)
+	}
+
+	c.metrics.getVertexMetricsSuccessCounter.Inc(ctx)
+	return vertexMetrics, nil
+}
+
 func (c *FlinkJobManagerClient) SavepointJob(ctx context.Context, url string, jobID string) (string, error) {
 	path := fmt.Sprintf(savepointURL, jobID)
 
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
@@ -26,8 +27,10 @@ const fakeOverviewURL = "http://abc.com/overview"
 const fakeJobConfigURL = "http://abc.com/jobs/1/config"
 const fakeSavepointURL = "http://abc.com/jobs/1/savepoints/2"
 const fakeSubmitURL = "http://abc.com/jars/1/run"
+const fakeUploadURL = "http://abc.com/jars/upload"
 const fakeCancelURL = "http://abc.com/jobs/1/savepoints"
 const fakeTaskmanagersURL = "http://abc.com/taskmanagers"
+const fakeVertexMetricsURL = "http://abc.com/jobs/1/vertices/2/subtasks/metrics"
 
 func getTestClient() FlinkJobManagerClient {
 	return FlinkJobManagerClient{}
@@ -313,6 +316,41 @@ func TestSubmitJobHappyCase(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUploadJarHappyCase(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	ctx := context.Background()
+	httpmock.RegisterResponder("POST", fakeUploadURL, func(req *http.Request) (*http.Response, error) {
+		file, header, err := req.FormFile("jarfile")
+		assert.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "job.jar", header.Filename)
+		return httpmock.NewJsonResponse(200, UploadJarResponse{
+			FileName: "/tmp/flink-web-upload/d1b6a2c0_job.jar",
+			Status:   "success",
+		})
+	})
+
+	client := getTestJobManagerClient()
+	resp, err := client.UploadJar(ctx, testURL, "job.jar", strings.NewReader("jar contents"))
+	assert.NoError(t, err)
+	assert.Equal(t, "d1b6a2c0_job.jar", resp.JarID)
+}
+
+func TestUploadJarInvalidResponse(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	ctx := context.Background()
+	responder, _ := httpmock.NewJsonResponder(200, invalidTestResponse)
+	httpmock.RegisterResponder("POST", fakeUploadURL, responder)
+
+	client := getTestJobManagerClient()
+	resp, err := client.UploadJar(ctx, testURL, "job.jar", strings.NewReader("jar contents"))
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "UploadJar call failed"))
+}
+
 func TestSubmitJobInvalidResponse(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -519,3 +557,52 @@ func TestGetTaskManagersInvalidResponse(t *testing.T) {
 	_, err := client.GetJobs(ctx, testURL)
 	assert.NotNil(t, err)
 }
+
+func TestGetJobVertexMetricsHappyCase(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	ctx := context.Background()
+	response := []JobVertexMetric{
+		{
+			ID:  "busyTimeMsPerSecond",
+			Min: 100,
+			Max: 900,
+			Avg: 500,
+			Sum: 1000,
+		},
+	}
+	responder, _ := httpmock.NewJsonResponder(200, response)
+	httpmock.RegisterResponder("GET", fakeVertexMetricsURL+"?get=busyTimeMsPerSecond%2CbackPressuredTimeMsPerSecond", responder)
+
+	client := getTestJobManagerClient()
+	resp, err := client.GetJobVertexMetrics(ctx, testURL, "1", "2",
+		[]string{"busyTimeMsPerSecond", "backPressuredTimeMsPerSecond"})
+	assert.NoError(t, err)
+	assert.Equal(t, response, resp)
+}
+
+func TestGetJobVertexMetricNames(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	ctx := context.Background()
+	responder := httpmock.NewStringResponder(200, `[{"id":"busyTimeMsPerSecond"},{"id":"numRecordsIn"}]`)
+	httpmock.RegisterResponder("GET", fakeVertexMetricsURL, responder)
+
+	client := getTestJobManagerClient()
+	resp, err := client.GetJobVertexMetrics(ctx, testURL, "1", "2", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []JobVertexMetric{{ID: "busyTimeMsPerSecond"}, {ID: "numRecordsIn"}}, resp)
+}
+
+func TestGetJobVertexMetrics500Response(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	ctx := context.Background()
+	responder, _ := httpmock.NewJsonResponder(500, nil)
+	httpmock.RegisterResponder("GET", fakeVertexMetricsURL, responder)
+
+	client := getTestJobManagerClient()
+	resp, err := client.GetJobVertexMetrics(ctx, testURL, "1", "2", nil)
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, "GetJobVertexMetrics call failed with status 500 and message ''")
+}
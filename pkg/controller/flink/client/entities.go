@@ -71,6 +71,14 @@ type SubmitJobResponse struct {
 	JobID string `json:"jobid"`
 }
 
+type UploadJarResponse struct {
+	// The path the jar is stored at on the JobManager
+	FileName string `json:"filename"`
+	Status   string `json:"status"`
+	// The ID to submit jobs from the jar with, which is derived from the file name
+	JarID string `json:"-"`
+}
+
 type GetJobsResponse struct {
 	Jobs []FlinkJob `json:"jobs"`
 }
@@ -109,6 +117,15 @@ type FlinkJobOverview struct {
 	Vertices  []FlinkJobVertex `json:"vertices"`
 }
 
+// A metric of a job vertex, aggregated over the subtasks of the vertex
+type JobVertexMetric struct {
+	ID  string  `json:"id"`
+	Min float64 `json:"min,omitempty"`
+	Max float64 `json:"max,omitempty"`
+	Avg float64 `json:"avg,omitempty"`
+	Sum float64 `json:"sum,omitempty"`
+}
+
 type ClusterOverviewResponse struct {
 	TaskManagerCount  int32 `json:"taskmanagers"`
 	SlotsAvailable    int32 `json:"slots-available"`
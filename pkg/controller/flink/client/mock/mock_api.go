@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"io"
 
 	"github.com/lyft/flinkk8soperator/pkg/controller/flink/client"
 )
@@ -18,6 +19,8 @@ type GetTaskManagersFunc func(ctx context.Context, url string) (*client.TaskMana
 type GetCheckpointCountsFunc func(ctx context.Context, url string, jobID string) (*client.CheckpointResponse, error)
 type GetJobOverviewFunc func(ctx context.Context, url string, jobID string) (*client.FlinkJobOverview, error)
 type SavepointJobFunc func(ctx context.Context, url string, jobID string) (string, error)
+type GetJobVertexMetricsFunc func(ctx context.Context, url string, jobID string, vertexID string, metrics []string) ([]client.JobVertexMetric, error)
+type UploadJarFunc func(ctx context.Context, url string, fileName string, jar io.Reader) (*client.UploadJarResponse, error)
 type JobManagerClient struct {
 	CancelJobWithSavepointFunc CancelJobWithSavepointFunc
 	ForceCancelJobFunc         ForceCancelJobFunc
@@ -31,6 +34,8 @@ type JobManagerClient struct {
 	GetCheckpointCountsFunc    GetCheckpointCountsFunc
 	GetJobOverviewFunc         GetJobOverviewFunc
 	SavepointJobFunc           SavepointJobFunc
+	GetJobVertexMetricsFunc    GetJobVertexMetricsFunc
+	UploadJarFunc              UploadJarFunc
 }
 
 func (m *JobManagerClient) SubmitJob(ctx context.Context, url string, jarID string, submitJobRequest client.SubmitJobRequest) (*client.SubmitJobResponse, error) {
@@ -117,3 +122,17 @@ func (m *JobManagerClient) SavepointJob(ctx context.Context, url string, jobID s
 
 	return "", nil
 }
+
+func (m *JobManagerClient) GetJobVertexMetrics(ctx context.Context, url string, jobID string, vertexID string, metrics []string) ([]client.JobVertexMetric, error) {
+	if m.GetJobVertexMetricsFunc != nil {
+		return m.GetJobVertexMetricsFunc(ctx, url, jobID, vertexID, metrics)
+	}
+	return nil, nil
+}
+
+func (m *JobManagerClient) UploadJar(ctx context.Context, url string, fileName string, jar io.Reader) (*client.UploadJarResponse, error) {
+	if m.UploadJarFunc != nil {
+		return m.UploadJarFunc(ctx, url, fileName, jar)
+	}
+	return nil, nil
+}
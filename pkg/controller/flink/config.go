@@ -3,23 +3,32 @@ package flink
 import (
 	"fmt"
 	"math"
+	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/pkg/errors"
 )
 
 const (
-	JobManagerDefaultReplicaCount  = 1
-	TaskManagerDefaultSlots        = 16
-	RPCDefaultPort                 = 6123
-	QueryDefaultPort               = 6124
-	BlobDefaultPort                = 6125
-	UIDefaultPort                  = 8081
-	MetricsQueryDefaultPort        = 50101
+	JobManagerDefaultReplicaCount  = v1beta1.DefaultJobManagerReplicas
+	TaskManagerDefaultSlots        = v1beta1.DefaultTaskManagerSlots
+	RPCDefaultPort                 = v1beta1.DefaultRPCPort
+	QueryDefaultPort               = v1beta1.DefaultQueryPort
+	BlobDefaultPort                = v1beta1.DefaultBlobPort
+	UIDefaultPort                  = v1beta1.DefaultUIPort
+	MetricsQueryDefaultPort        = v1beta1.DefaultMetricsQueryPort
 	OffHeapMemoryDefaultFraction   = 0.5
 	HighAvailabilityKey            = "high-availability"
+	SavepointDirKey                = "state.savepoints.dir"
 	MaxCheckpointRestoreAgeSeconds = 3600
+
+	KubernetesHAServicesFactory = "org.apache.flink.kubernetes.highavailability.KubernetesHaServicesFactory"
+	// Labels set by Flink on the ConfigMaps created by the Kubernetes HA services
+	HAConfigMapTypeLabel = "configmap-type"
+	HAConfigMapType      = "high-availability"
+	HAClusterIDLabel     = "app"
 )
 
 func firstNonNil(x *int32, y int32) int32 {
@@ -127,6 +136,19 @@ func renderFlinkConfig(app *v1beta1.FlinkApplication) (string, error) {
 	(*config)["jobmanager.heap.size"] = getJobManagerHeapMemory(app)
 	(*config)["taskmanager.heap.size"] = getTaskManagerHeapMemory(app)
 
+	if v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) {
+		(*config)["parallelism.default"] = GetParallelism(app)
+		// keep the job manager up once the job has been cancelled, as it would otherwise be restarted and run the
+		// job again
+		(*config)["execution.shutdown-on-application-finish"] = false
+	}
+
+	// the settings that depend on the hash are added in InjectOperatorCustomizedConfig
+	if app.Spec.HighAvailability != nil {
+		(*config)[HighAvailabilityKey] = KubernetesHAServicesFactory
+		(*config)["kubernetes.namespace"] = app.Namespace
+	}
+
 	// get the keys for the map
 	var keys = make([]string, len(*config))
 	i := 0
@@ -158,8 +180,11 @@ func renderFlinkConfig(app *v1beta1.FlinkApplication) (string, error) {
 	return s.String(), nil
 }
 
-func isHAEnabled(flinkConfig v1beta1.FlinkConfig) bool {
-	if val, ok := flinkConfig[HighAvailabilityKey]; ok {
+func isHAEnabled(app *v1beta1.FlinkApplication) bool {
+	if app.Spec.HighAvailability != nil {
+		return true
+	}
+	if val, ok := app.Spec.FlinkConfig[HighAvailabilityKey]; ok {
 		value := val.(string)
 		if strings.ToLower(strings.TrimSpace(value)) != "none" {
 			return true
@@ -167,3 +192,88 @@ func isHAEnabled(flinkConfig v1beta1.FlinkConfig) bool {
 	}
 	return false
 }
+
+// The cluster id under which the HA services of a version of the application store their data. Every hash gets its
+// own id, so that the two versions of a BlueGreen deployment do not compete for leadership.
+func getHAClusterID(app *v1beta1.FlinkApplication, hash string) string {
+	return fmt.Sprintf("%s-%s", app.Name, hash)
+}
+
+func getHAStorageDir(app *v1beta1.FlinkApplication, hash string) string {
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(app.Spec.HighAvailability.StorageDir, "/"), hash)
+}
+
+// Matches a single program argument, which may be quoted. This is the same tokenization that Flink applies to the
+// programArgs of a job submitted through the REST API.
+var programArgPattern = regexp.MustCompile(`([^"']\S*|".+?"|'.+?')\s*`)
+
+func splitProgramArgs(programArgs string) []string {
+	var args []string
+	for _, match := range programArgPattern.FindAllStringSubmatch(strings.TrimSpace(programArgs), -1) {
+		arg := match[1]
+		if len(arg) > 1 && (arg[0] == '"' || arg[0] == '\'') && arg[len(arg)-1] == arg[0] {
+			arg = arg[1 : len(arg)-1]
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// Returns the savepoint that the job for the current version of the application should be started from
+func GetSavepointPathForDeploy(app *v1beta1.FlinkApplication) string {
+	if IsRestoreFromResolved(app) && !app.Status.RestoreFrom.Restored {
+		// the user has picked an earlier savepoint to start from, which only applies to a single deploy
+		return app.Status.RestoreFrom.Path
+	}
+
+	if app.Status.DeployHash != "" || app.Status.SavepointPath != "" {
+		// use the savepoint created by the operator, which is kept while the application is suspended
+		return app.Status.SavepointPath
+	}
+
+	// this is the first deploy, use the user-provided savepoint
+	if app.Spec.SavepointPath != "" {
+		return app.Spec.SavepointPath
+	}
+	//nolint // fall back to the old config for backwards-compatibility
+	return app.Spec.SavepointInfo.SavepointLocation
+}
+
+// Returns whether status.restoreFrom records the savepoint that the current spec.restoreFrom was resolved to
+func IsRestoreFromResolved(app *v1beta1.FlinkApplication) bool {
+	restoreFrom, resolved := app.Spec.RestoreFrom, app.Status.RestoreFrom
+	if restoreFrom == nil || resolved == nil || restoreFrom.Hash != resolved.Hash {
+		return false
+	}
+	if restoreFrom.Index == nil || resolved.Index == nil {
+		return restoreFrom.Index == resolved.Index
+	}
+	return *restoreFrom.Index == *resolved.Index
+}
+
+// Returns the savepoint of the history that spec.restoreFrom refers to. The savepoint that was taken of the previous
+// job during the current deploy does not count as part of the history.
+func GetRestoreFromPath(app *v1beta1.FlinkApplication) (string, error) {
+	var history []v1beta1.SavepointRecord
+	for _, record := range app.Status.SavepointHistory {
+		if app.Status.SavepointPath == "" || record.Path != app.Status.SavepointPath {
+			history = append(history, record)
+		}
+	}
+
+	restoreFrom := app.Spec.RestoreFrom
+	if restoreFrom.Index != nil {
+		index := int(*restoreFrom.Index)
+		if index < 0 || index >= len(history) {
+			return "", errors.Errorf("the savepoint history has no entry at index %d", index)
+		}
+		return history[len(history)-1-index].Path, nil
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Hash == restoreFrom.Hash {
+			return history[i].Path, nil
+		}
+	}
+	return "", errors.Errorf("the savepoint history has no savepoint of version %s", restoreFrom.Hash)
+}
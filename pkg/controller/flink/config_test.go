@@ -184,3 +184,69 @@ func TestGetJobManagerHeapMemory(t *testing.T) {
 
 	assert.Equal(t, "32768k", getJobManagerHeapMemory(&app))
 }
+
+func TestGetRestoreFromPath(t *testing.T) {
+	app := v1beta1.FlinkApplication{}
+	app.Status.DeployHash = "hash-3"
+	app.Status.SavepointHistory = []v1beta1.SavepointRecord{
+		{Path: "s3://savepoints/1", Reason: v1beta1.SavepointReasonUpdate, Hash: "hash-1"},
+		{Path: "s3://savepoints/2", Reason: v1beta1.SavepointReasonScheduled, Hash: "hash-2"},
+		{Path: "s3://savepoints/3", Reason: v1beta1.SavepointReasonUpdate, Hash: "hash-2"},
+		{Path: "s3://savepoints/4", Reason: v1beta1.SavepointReasonUpdate, Hash: "hash-3"},
+	}
+	// the savepoint taken of the running job during this deploy
+	app.Status.SavepointPath = "s3://savepoints/4"
+
+	index := int32(0)
+	app.Spec.RestoreFrom = &v1beta1.RestoreFrom{Index: &index}
+	path, err := GetRestoreFromPath(&app)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3://savepoints/3", path)
+
+	index = 2
+	path, err = GetRestoreFromPath(&app)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3://savepoints/1", path)
+
+	index = 3
+	_, err = GetRestoreFromPath(&app)
+	assert.NotNil(t, err)
+
+	app.Spec.RestoreFrom = &v1beta1.RestoreFrom{Hash: "hash-2"}
+	path, err = GetRestoreFromPath(&app)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3://savepoints/3", path)
+
+	app.Spec.RestoreFrom = &v1beta1.RestoreFrom{Hash: "hash-4"}
+	_, err = GetRestoreFromPath(&app)
+	assert.NotNil(t, err)
+}
+
+func TestGetSavepointPathForDeployWithRestoreFrom(t *testing.T) {
+	app := v1beta1.FlinkApplication{}
+	app.Status.DeployHash = "hash-3"
+	app.Status.SavepointPath = "s3://savepoints/4"
+
+	// spec.restoreFrom only applies once it has been resolved for the deploy
+	index := int32(0)
+	app.Spec.RestoreFrom = &v1beta1.RestoreFrom{Index: &index}
+	assert.Equal(t, "s3://savepoints/4", GetSavepointPathForDeploy(&app))
+
+	resolvedIndex := int32(0)
+	app.Status.RestoreFrom = &v1beta1.RestoreFromStatus{Index: &resolvedIndex, Path: "s3://savepoints/3"}
+	assert.True(t, IsRestoreFromResolved(&app))
+	assert.Equal(t, "s3://savepoints/3", GetSavepointPathForDeploy(&app))
+
+	// once a job has been started from it, later deploys use the savepoint of the running job
+	app.Status.RestoreFrom.Restored = true
+	assert.Equal(t, "s3://savepoints/4", GetSavepointPathForDeploy(&app))
+
+	// a different savepoint needs to be resolved again
+	app.Status.RestoreFrom.Restored = false
+	index = 1
+	assert.False(t, IsRestoreFromResolved(&app))
+	assert.Equal(t, "s3://savepoints/4", GetSavepointPathForDeploy(&app))
+
+	app.Spec.RestoreFrom = &v1beta1.RestoreFrom{Hash: "hash-2"}
+	assert.False(t, IsRestoreFromResolved(&app))
+}
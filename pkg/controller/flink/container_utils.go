@@ -24,6 +24,7 @@ const (
 	AwsMetadataServiceTimeout        = "5"
 	AwsMetadataServiceNumAttempts    = "20"
 	OperatorFlinkConfig              = "FLINK_PROPERTIES"
+	LegacyOperatorFlinkConfig        = "OPERATOR_FLINK_CONFIG"
 	HostName                         = "HOST_NAME"
 	HostIP                           = "HOST_IP"
 	FlinkDeploymentTypeEnv           = "FLINK_DEPLOYMENT_TYPE"
@@ -33,6 +34,8 @@ const (
 	FlinkAppHash                     = "flink-app-hash"
 	FlinkJobProperties               = "flink-job-properties"
 	RestartNonce                     = "restart-nonce"
+	RestoreFrom                      = "restore-from"
+	AutoscaledParallelism            = "flink-autoscaled-parallelism"
 	FlinkApplicationVersionEnv       = "FLINK_APPLICATION_VERSION"
 	FlinkApplicationVersion          = "flink-application-version"
 )
@@ -54,14 +57,35 @@ func getCommonAppLabels(app *v1beta1.FlinkApplication) map[string]string {
 	return labels
 }
 
-func getCommonAnnotations(app *v1beta1.FlinkApplication) map[string]string {
+// The annotations of the application, without those that only concern the operator. The parallelism chosen by the
+// autoscaler is part of the job properties if it applies, so a stale one does not change the hash of the application.
+func getPodAnnotations(app *v1beta1.FlinkApplication) map[string]string {
 	annotations := common.DuplicateMap(app.Annotations)
+	delete(annotations, AutoscaledParallelism)
+	return annotations
+}
+
+func getCommonAnnotations(app *v1beta1.FlinkApplication) map[string]string {
+	annotations := getPodAnnotations(app)
 	annotations[FlinkJobProperties] = fmt.Sprintf(
 		"jarName: %s\nparallelism: %d\nentryClass:%s\nprogramArgs:\"%s\"",
-		app.Spec.JarName, app.Spec.Parallelism, app.Spec.EntryClass, app.Spec.ProgramArgs)
+		app.Spec.JarName, GetParallelism(app), app.Spec.EntryClass, app.Spec.ProgramArgs)
+	if app.Spec.JarURI != "" {
+		// a new jar at the same URI is only picked up when the checksum changes
+		annotations[FlinkJobProperties] += fmt.Sprintf("\njarURI: %s\njarChecksum: %s",
+			app.Spec.JarURI, app.Spec.JarChecksum)
+	}
 	if app.Spec.RestartNonce != "" {
 		annotations[RestartNonce] = app.Spec.RestartNonce
 	}
+	if restoreFrom := app.Spec.RestoreFrom; restoreFrom != nil {
+		// restoring from another savepoint requires a new deploy
+		if restoreFrom.Index != nil {
+			annotations[RestoreFrom] = fmt.Sprintf("index:%d", *restoreFrom.Index)
+		} else {
+			annotations[RestoreFrom] = "hash:" + restoreFrom.Hash
+		}
+	}
 	if v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
 		annotations[FlinkApplicationVersion] = string(app.Status.UpdatingVersion)
 	}
@@ -147,9 +171,13 @@ func fromHashToByteArray(input [32]byte) []byte {
 
 // Generate a deterministic hash in bytes for the pb object
 func ComputeDeploymentHash(deployment appsv1.Deployment) ([]byte, error) {
+	return computeObjectHash(deployment)
+}
+
+func computeObjectHash(obj interface{}) ([]byte, error) {
 	// json marshalling includes:
 	// - omitting empty values which supports backwards compatibility of old protobuf definitions
-	jsonObj, err := json.Marshal(deployment)
+	jsonObj, err := json.Marshal(obj)
 	if err != nil {
 		return nil, err
 	}
@@ -171,8 +199,8 @@ func HashForApplication(app *v1beta1.FlinkApplication) string {
 	jmDeployment := jobmanagerTemplate(app)
 	jmDeployment.OwnerReferences = make([]metav1.OwnerReference, 0)
 
-	tmDeployment := taskmanagerTemplate(app)
-	tmDeployment.OwnerReferences = make([]metav1.OwnerReference, 0)
+	tmTemplate := taskmanagerObjectTemplate(app)
+	tmTemplate.SetOwnerReferences(make([]metav1.OwnerReference, 0))
 
 	jmHashBytes, err := ComputeDeploymentHash(*jmDeployment)
 	if err != nil {
@@ -180,7 +208,7 @@ func HashForApplication(app *v1beta1.FlinkApplication) string {
 		panic(fmt.Sprintf("got error trying when computing hash %v", err))
 	}
 
-	tmHashBytes, err := ComputeDeploymentHash(*tmDeployment)
+	tmHashBytes, err := computeObjectHash(tmTemplate)
 	if err != nil {
 		// the hasher cannot actually throw an error on write
 		panic(fmt.Sprintf("got error trying when computing hash %v", err))
@@ -201,14 +229,32 @@ func HashForApplication(app *v1beta1.FlinkApplication) string {
 	return fmt.Sprintf("%08x", hasher.Sum32())
 }
 
+// Adds the settings that depend on the hash to the Flink config of the Flink container. Other containers, such as
+// sidecars and init containers, receive the resulting config only if they declare an OPERATOR_FLINK_CONFIG or
+// FLINK_PROPERTIES env var without a value.
 func InjectOperatorCustomizedConfig(deployment *appsv1.Deployment, app *v1beta1.FlinkApplication, hash string, deploymentType string) {
-	var newContainers []v1.Container
-	for _, container := range deployment.Spec.Template.Spec.Containers {
+	flinkContainerName := getFlinkContainerName(TaskManagerContainerName)
+	if deploymentType == FlinkDeploymentTypeJobmanager {
+		flinkContainerName = getFlinkContainerName(JobManagerContainerName)
+	}
+
+	var flinkConfig string
+	podSpec := &deployment.Spec.Template.Spec
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		if container.Name != flinkContainerName {
+			continue
+		}
+
 		var newEnv []v1.EnvVar
 		for _, env := range container.Env {
 			if env.Name == OperatorFlinkConfig {
-				if isHAEnabled(app.Spec.FlinkConfig) {
-					env.Value = fmt.Sprintf("%s\nhigh-availability.cluster-id: %s-%s\n", env.Value, app.Name, hash)
+				if isHAEnabled(app) {
+					env.Value = fmt.Sprintf("%s\nhigh-availability.cluster-id: %s\n", env.Value, getHAClusterID(app, hash))
+					if app.Spec.HighAvailability != nil {
+						env.Value = fmt.Sprintf("%skubernetes.cluster-id: %s\nhigh-availability.storageDir: %s\n",
+							env.Value, getHAClusterID(app, hash), getHAStorageDir(app, hash))
+					}
 					if deploymentType == FlinkDeploymentTypeJobmanager {
 						env.Value = fmt.Sprintf("%sjobmanager.rpc.address: $HOST_IP\n", env.Value)
 					}
@@ -218,15 +264,115 @@ func InjectOperatorCustomizedConfig(deployment *appsv1.Deployment, app *v1beta1.
 				if deploymentType == FlinkDeploymentTypeTaskmanager {
 					env.Value = fmt.Sprintf("%staskmanager.host: $HOST_IP\n", env.Value)
 				}
+				flinkConfig = env.Value
 				// backward compatibility: https://github.com/lyft/flinkk8soperator/issues/135
-				newEnv = append(newEnv, v1.EnvVar{Name: "OPERATOR_FLINK_CONFIG", Value: env.Value})
+				newEnv = append(newEnv, v1.EnvVar{Name: LegacyOperatorFlinkConfig, Value: env.Value})
 			}
 			newEnv = append(newEnv, env)
 		}
 		container.Env = newEnv
-		newContainers = append(newContainers, container)
 	}
-	deployment.Spec.Template.Spec.Containers = newContainers
+
+	injectFlinkConfigEnv(podSpec.Containers, flinkContainerName, flinkConfig)
+	injectFlinkConfigEnv(podSpec.InitContainers, flinkContainerName, flinkConfig)
+}
+
+func injectFlinkConfigEnv(containers []v1.Container, flinkContainerName string, flinkConfig string) {
+	for i := range containers {
+		if containers[i].Name == flinkContainerName {
+			continue
+		}
+		for j := range containers[i].Env {
+			env := &containers[i].Env[j]
+			if (env.Name == OperatorFlinkConfig || env.Name == LegacyOperatorFlinkConfig) &&
+				env.Value == "" && env.ValueFrom == nil {
+				env.Value = flinkConfig
+			}
+		}
+	}
+}
+
+// Merges the volumes of the job manager or task manager config with those of the application. A volume of the
+// component replaces the application's volume of the same name.
+func mergeVolumes(appVolumes []v1.Volume, componentVolumes []v1.Volume) []v1.Volume {
+	if len(componentVolumes) == 0 {
+		return appVolumes
+	}
+
+	names := make(map[string]int, len(componentVolumes))
+	for i, volume := range componentVolumes {
+		names[volume.Name] = i
+	}
+	var volumes []v1.Volume
+	added := make(map[string]bool, len(componentVolumes))
+	for _, volume := range appVolumes {
+		if i, ok := names[volume.Name]; ok {
+			volume = componentVolumes[i]
+			added[volume.Name] = true
+		}
+		volumes = append(volumes, volume)
+	}
+	for _, volume := range componentVolumes {
+		if !added[volume.Name] {
+			volumes = append(volumes, volume)
+		}
+	}
+	return volumes
+}
+
+// Merges the volume mounts of the job manager or task manager config with those of the application. A mount of the
+// component replaces the application's mount at the same path.
+func mergeVolumeMounts(appMounts []v1.VolumeMount, componentMounts []v1.VolumeMount) []v1.VolumeMount {
+	if len(componentMounts) == 0 {
+		return appMounts
+	}
+
+	paths := make(map[string]int, len(componentMounts))
+	for i, mount := range componentMounts {
+		paths[mount.MountPath] = i
+	}
+	var mounts []v1.VolumeMount
+	added := make(map[string]bool, len(componentMounts))
+	for _, mount := range appMounts {
+		if i, ok := paths[mount.MountPath]; ok {
+			mount = componentMounts[i]
+			added[mount.MountPath] = true
+		}
+		mounts = append(mounts, mount)
+	}
+	for _, mount := range componentMounts {
+		if !added[mount.MountPath] {
+			mounts = append(mounts, mount)
+		}
+	}
+	return mounts
+}
+
+// Sidecars and init containers share the volumes of the pod. The volume mounts of the Flink container are added to
+// each of them, except for those whose path the container already mounts something else at.
+func withVolumeMounts(volumeMounts []v1.VolumeMount, containers []v1.Container) []v1.Container {
+	if len(containers) == 0 {
+		return nil
+	}
+
+	result := make([]v1.Container, len(containers))
+	for i, container := range containers {
+		mountPaths := make(map[string]bool, len(container.VolumeMounts))
+		for _, mount := range container.VolumeMounts {
+			mountPaths[mount.MountPath] = true
+		}
+		mounts := append([]v1.VolumeMount{}, container.VolumeMounts...)
+		for _, mount := range volumeMounts {
+			if !mountPaths[mount.MountPath] {
+				mounts = append(mounts, mount)
+			}
+		}
+		if len(mounts) > 0 {
+			container.VolumeMounts = mounts
+		}
+		result[i] = container
+	}
+	return result
 }
 
 // Injects labels and environment variables required for blue green deploys
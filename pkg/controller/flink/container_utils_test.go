@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/common"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -75,3 +76,120 @@ func TestHashForDifferentResourceScales(t *testing.T) {
 
 	assert.Equal(t, HashForApplication(&app1), HashForApplication(&app2))
 }
+
+func TestHashForDefaultedApplication(t *testing.T) {
+	app := getFlinkTestApp()
+	hash := HashForApplication(&app)
+
+	v1beta1.SetObjectDefaults_FlinkApplication(&app)
+	assert.Equal(t, v1beta1.DeploymentModeDual, app.Spec.DeploymentMode)
+	assert.Equal(t, int32(TaskManagerDefaultSlots), *app.Spec.TaskManagerConfig.TaskSlots)
+	assert.Equal(t, int32(RPCDefaultPort), *app.Spec.RPCPort)
+	assert.Equal(t, JobManagerDefaultResources, *app.Spec.JobManagerConfig.Resources)
+
+	// materializing the defaults must not cause existing applications to be redeployed
+	assert.Equal(t, hash, HashForApplication(&app))
+}
+
+func TestKubernetesHighAvailabilityConfig(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	app.Status.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	app.Spec.HighAvailability = &v1beta1.HighAvailabilityConfig{StorageDir: "s3://flink/ha/"}
+
+	for _, hash := range []string{"bluehash", "greenhash"} {
+		jm := FetchJobMangerDeploymentCreateObj(&app, hash)
+		tm := FetchTaskMangerDeploymentCreateObj(&app, hash)
+
+		for _, flinkConfig := range []string{
+			common.GetEnvVar(jm.Spec.Template.Spec.Containers[0].Env, OperatorFlinkConfig).Value,
+			common.GetEnvVar(tm.Spec.Template.Spec.Containers[0].Env, OperatorFlinkConfig).Value,
+		} {
+			assert.Contains(t, flinkConfig, "high-availability: "+KubernetesHAServicesFactory+"\n")
+			assert.Contains(t, flinkConfig, "kubernetes.namespace: "+app.Namespace+"\n")
+			// the job and task managers of a version share a cluster id that is distinct from the other version
+			assert.Contains(t, flinkConfig, "high-availability.cluster-id: app-name-"+hash+"\n")
+			assert.Contains(t, flinkConfig, "kubernetes.cluster-id: app-name-"+hash+"\n")
+			assert.Contains(t, flinkConfig, "high-availability.storageDir: s3://flink/ha/"+hash+"\n")
+		}
+		assert.Contains(t, common.GetEnvVar(jm.Spec.Template.Spec.Containers[0].Env, OperatorFlinkConfig).Value,
+			"jobmanager.rpc.address: $HOST_IP\n")
+	}
+}
+
+func TestInjectOperatorCustomizedConfigSidecars(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.Volumes = []v1.Volume{{Name: "logs"}}
+	app.Spec.VolumeMounts = []v1.VolumeMount{{Name: "logs", MountPath: "/var/log/flink"}}
+	app.Spec.TaskManagerConfig.Sidecars = []v1.Container{
+		{
+			Name:  "log-shipper",
+			Image: "fluent-bit",
+			Env: []v1.EnvVar{
+				{Name: LegacyOperatorFlinkConfig},
+				{Name: OperatorFlinkConfig, Value: "custom"},
+			},
+		},
+	}
+	app.Spec.TaskManagerConfig.InitContainers = []v1.Container{
+		{
+			Name:         "fetch-secrets",
+			Image:        "vault",
+			VolumeMounts: []v1.VolumeMount{{Name: "secrets", MountPath: "/var/log/flink"}},
+		},
+	}
+
+	deployment := FetchTaskMangerDeploymentCreateObj(&app, testAppHash)
+	podSpec := deployment.Spec.Template.Spec
+
+	assert.Equal(t, 2, len(podSpec.Containers))
+	flinkConfig := common.GetEnvVar(podSpec.Containers[0].Env, OperatorFlinkConfig).Value
+	assert.Contains(t, flinkConfig, "taskmanager.host: $HOST_IP\n")
+	assert.Equal(t, flinkConfig, common.GetEnvVar(podSpec.Containers[0].Env, LegacyOperatorFlinkConfig).Value)
+
+	sidecar := podSpec.Containers[1]
+	assert.Equal(t, flinkConfig, common.GetEnvVar(sidecar.Env, LegacyOperatorFlinkConfig).Value)
+	assert.Equal(t, "custom", common.GetEnvVar(sidecar.Env, OperatorFlinkConfig).Value)
+	assert.Equal(t, app.Spec.VolumeMounts, sidecar.VolumeMounts)
+
+	assert.Equal(t, 1, len(podSpec.InitContainers))
+	assert.Equal(t, []v1.VolumeMount{{Name: "secrets", MountPath: "/var/log/flink"}}, podSpec.InitContainers[0].VolumeMounts)
+
+	// the sidecars are part of the hash
+	h1 := HashForApplication(&app)
+	app.Spec.TaskManagerConfig.Sidecars[0].Image = "fluentd"
+	assert.NotEqual(t, h1, HashForApplication(&app))
+}
+
+func TestComponentVolumes(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.Volumes = []v1.Volume{{Name: "config"}, {Name: "logs"}}
+	app.Spec.VolumeMounts = []v1.VolumeMount{
+		{Name: "config", MountPath: "/etc/flink"},
+		{Name: "logs", MountPath: "/var/log/flink"},
+	}
+	h1 := HashForApplication(&app)
+
+	localSSD := v1.Volume{
+		Name: "logs",
+		VolumeSource: v1.VolumeSource{
+			HostPath: &v1.HostPathVolumeSource{Path: "/mnt/disks/ssd0"},
+		},
+	}
+	app.Spec.TaskManagerConfig.Volumes = []v1.Volume{localSSD, {Name: "rocksdb"}}
+	app.Spec.TaskManagerConfig.VolumeMounts = []v1.VolumeMount{{Name: "rocksdb", MountPath: "/rocksdb"}}
+
+	jmDeployment := FetchJobMangerDeploymentCreateObj(&app, testAppHash)
+	assert.Equal(t, app.Spec.Volumes, jmDeployment.Spec.Template.Spec.Volumes)
+	assert.Equal(t, app.Spec.VolumeMounts, jmDeployment.Spec.Template.Spec.Containers[0].VolumeMounts)
+
+	tmDeployment := FetchTaskMangerDeploymentCreateObj(&app, testAppHash)
+	assert.Equal(t, []v1.Volume{{Name: "config"}, localSSD, {Name: "rocksdb"}}, tmDeployment.Spec.Template.Spec.Volumes)
+	assert.Equal(t, []v1.VolumeMount{
+		{Name: "config", MountPath: "/etc/flink"},
+		{Name: "logs", MountPath: "/var/log/flink"},
+		{Name: "rocksdb", MountPath: "/rocksdb"},
+	}, tmDeployment.Spec.Template.Spec.Containers[0].VolumeMounts)
+
+	assert.NotEqual(t, h1, HashForApplication(&app))
+}
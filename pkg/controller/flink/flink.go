@@ -1,9 +1,11 @@
 package flink
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -21,7 +23,9 @@ import (
 	"github.com/lyft/flytestdlib/promutils/labeled"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	k8_err "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -53,6 +57,11 @@ type ControllerInterface interface {
 	// Force cancels the running/active job without taking a savepoint
 	ForceCancel(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) error
 
+	// Downloads the jar at the given URI, verifies its checksum and uploads it to the Flink Cluster. Returns the id
+	// of the uploaded jar, which is used as the jar name when starting the job.
+	UploadJar(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jarURI string,
+		checksum string) (string, error)
+
 	// Starts the Job in the Flink Cluster
 	StartFlinkJob(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
 		jarName string, parallelism int32, entryClass string, programArgs string, allowNonRestoredState bool,
@@ -62,6 +71,10 @@ type ControllerInterface interface {
 	// Polls the status of the Savepoint, using the triggerID
 	GetSavepointStatus(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) (*client.SavepointResponse, error)
 
+	// Polls the status of a Savepoint that was triggered outside of a deploy, and so is not tracked by
+	// SavepointTriggerID in the status of the application
+	GetSavepointStatusForTrigger(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error)
+
 	// Check if the Flink Kubernetes Cluster is Ready.
 	// Checks if all the pods of task and job managers are ready.
 	IsClusterReady(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error)
@@ -81,6 +94,9 @@ type ControllerInterface interface {
 	// Deletes all old resources (deployments and services) for the app
 	DeleteOldResourcesForApp(ctx context.Context, app *v1beta1.FlinkApplication) error
 
+	// Deletes the HA ConfigMaps of every version of the app
+	DeleteHAConfigMapsForApp(ctx context.Context, app *v1beta1.FlinkApplication) error
+
 	// Attempts to find an externalized checkpoint for the job. This can be used to recover an application that is not
 	// able to savepoint for some reason.
 	FindExternalizedCheckpoint(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (string, error)
@@ -96,6 +112,15 @@ type ControllerInterface interface {
 	// Returns true if there is a change in JobStatus
 	CompareAndUpdateJobStatus(ctx context.Context, app *v1beta1.FlinkApplication, hash string) (bool, error)
 
+	// Creates the pod disruption budget of the cluster with the given hash, or updates it to match the application
+	// Returns true if the budget was created
+	UpdatePodDisruptionBudget(ctx context.Context, app *v1beta1.FlinkApplication, hash string) (bool, error)
+
+	// In application mode, restarts the job manager of the cluster with the given hash so that it runs the job again
+	// from the given savepoint. Returns true if the job manager is being restarted.
+	RestartJobManagerFromSavepoint(ctx context.Context, app *v1beta1.FlinkApplication, hash string,
+		savepointPath string) (bool, error)
+
 	// Gets the last updated cluster status
 	GetLatestClusterStatus(ctx context.Context, app *v1beta1.FlinkApplication) v1beta1.FlinkClusterStatus
 
@@ -127,6 +152,9 @@ type ControllerInterface interface {
 	GetVersionAndJobIDForHash(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (string, string, error)
 	// Get version and hash after teardown is complete
 	GetVersionAndHashPostTeardown(ctx context.Context, application *v1beta1.FlinkApplication) (v1beta1.FlinkApplicationVersion, string)
+
+	// Returns the most utilized of the metrics configured for the autoscaler, along with its utilization
+	GetAutoscalerUtilization(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (v1beta1.AutoscalerMetric, float64, error)
 }
 
 func NewController(k8sCluster k8.ClusterInterface, eventRecorder record.EventRecorder, config controllerConfig.RuntimeConfig) ControllerInterface {
@@ -235,6 +263,13 @@ func (f *Controller) deploymentMatches(ctx context.Context, deployment *v1.Deplo
 	return false
 }
 
+func (f *Controller) taskManagersMatch(ctx context.Context, deployment *common.FlinkDeployment, application *v1beta1.FlinkApplication, hash string) bool {
+	if deployment.TaskmanagerStatefulSet != nil {
+		return TaskManagerStatefulSetMatches(deployment.TaskmanagerStatefulSet, application, hash)
+	}
+	return f.deploymentMatches(ctx, deployment.Taskmanager, application, hash)
+}
+
 func (f *Controller) GetJobsForApplication(ctx context.Context, application *v1beta1.FlinkApplication, hash string) ([]client.FlinkJob, error) {
 	jobResponse, err := f.flinkClient.GetJobs(ctx, f.getURLFromApp(application, hash))
 	if err != nil {
@@ -287,13 +322,116 @@ func (f *Controller) CreateCluster(ctx context.Context, application *v1beta1.Fli
 		return err
 	}
 
-	if newlyCreatedJm || newlyCreatedTm {
+	newlyCreatedPdb, err := f.UpdatePodDisruptionBudget(ctx, application, HashForApplication(application))
+	if err != nil {
+		logger.Errorf(ctx, "Pod disruption budget creation did not succeed %v", err)
+		f.LogEvent(ctx, application, corev1.EventTypeWarning, "CreateClusterFailed",
+			fmt.Sprintf("Failed to create pod disruption budget for deploy %s: %v",
+				HashForApplication(application), err))
+		return err
+	}
+
+	if newlyCreatedJm || newlyCreatedTm || newlyCreatedPdb {
 		f.LogEvent(ctx, application, corev1.EventTypeNormal, "CreatingCluster",
 			fmt.Sprintf("Creating Flink cluster for deploy %s", HashForApplication(application)))
 	}
 	return nil
 }
 
+// spec.podDisruptionBudget is not part of the hash, so that changing it does not redeploy the cluster. Instead the
+// budgets of the running cluster are brought in line with it here. Returns true if a budget has been created.
+func (f *Controller) UpdatePodDisruptionBudget(ctx context.Context, app *v1beta1.FlinkApplication, hash string) (bool, error) {
+	labels := k8.GetAppLabel(app.Name)
+	labels[FlinkAppHash] = hash
+	budgets, err := f.k8Cluster.GetPodDisruptionBudgetsWithLabel(ctx, app.Namespace, labels)
+	if err != nil {
+		return false, err
+	}
+
+	newlyCreated := false
+	for _, budget := range FetchPodDisruptionBudgetsCreateObj(app, hash) {
+		created, err := f.updatePodDisruptionBudget(ctx, budget, budgets.Items)
+		if err != nil {
+			return false, err
+		}
+		newlyCreated = newlyCreated || created
+	}
+	return newlyCreated, nil
+}
+
+func (f *Controller) updatePodDisruptionBudget(ctx context.Context, budget *policyv1beta1.PodDisruptionBudget,
+	existing []policyv1beta1.PodDisruptionBudget) (bool, error) {
+	for _, b := range existing {
+		if b.Name != budget.Name {
+			continue
+		}
+		if apiequality.Semantic.DeepEqual(b.Spec.MaxUnavailable, budget.Spec.MaxUnavailable) &&
+			apiequality.Semantic.DeepEqual(b.Spec.Selector, budget.Spec.Selector) {
+			return false, nil
+		}
+
+		updated := b.DeepCopy()
+		updated.Spec.MaxUnavailable = budget.Spec.MaxUnavailable
+		updated.Spec.Selector = budget.Spec.Selector
+		logger.Infof(ctx, "Updating pod disruption budget %s", updated.Name)
+		return false, f.k8Cluster.UpdateK8Object(ctx, updated)
+	}
+
+	err := f.k8Cluster.CreateK8Object(ctx, budget)
+	if err != nil {
+		if !k8_err.IsAlreadyExists(err) {
+			return false, err
+		}
+		// the cache has not caught up yet, the budget is compared on the next call
+		logger.Infof(ctx, "Pod disruption budget %s already exists", budget.Name)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (f *Controller) RestartJobManagerFromSavepoint(ctx context.Context, app *v1beta1.FlinkApplication, hash string,
+	savepointPath string) (bool, error) {
+	labels := k8.GetAppLabel(app.Name)
+	labels[FlinkAppHash] = hash
+	deployments, err := f.k8Cluster.GetDeploymentsWithLabel(ctx, app.Namespace, labels)
+	if err != nil {
+		return false, err
+	}
+
+	for _, d := range deployments.Items {
+		if !DeploymentIsJobmanager(&d) || !JobManagerDeploymentMatches(&d, app, hash) {
+			continue
+		}
+
+		updated := d.DeepCopy()
+		if !setApplicationModeSavepoint(updated, savepointPath) {
+			// the job manager has already been restarted
+			return false, nil
+		}
+		logger.Infof(ctx, "Restarting job manager %s from savepoint %s", updated.Name, savepointPath)
+		return true, f.k8Cluster.UpdateK8Object(ctx, updated)
+	}
+	return false, fmt.Errorf("could not find the job manager of deploy %s", hash)
+}
+
+func (f *Controller) UploadJar(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+	jarURI string, checksum string) (string, error) {
+	jar, err := FetchJar(ctx, jarURI, checksum)
+	if err != nil {
+		return "", err
+	}
+	response, err := f.flinkClient.UploadJar(ctx, f.getURLFromApp(application, hash),
+		GetJarFileName(jarURI), bytes.NewReader(jar))
+	if err != nil {
+		return "", err
+	}
+	if response.JarID == "" {
+		logger.Errorf(ctx, "Jar id in the upload jar response was empty")
+		return "", errors.New("unable to upload jar: invalid jar id")
+	}
+	return response.JarID, nil
+}
+
 func (f *Controller) StartFlinkJob(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
 	jarName string, parallelism int32, entryClass string, programArgs string, allowNonRestoredState bool,
 	savepointPath string) (string, error) {
@@ -319,7 +457,11 @@ func (f *Controller) StartFlinkJob(ctx context.Context, application *v1beta1.Fli
 }
 
 func (f *Controller) GetSavepointStatus(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) (*client.SavepointResponse, error) {
-	return f.flinkClient.CheckSavepointStatus(ctx, f.getURLFromApp(application, hash), jobID, application.Status.SavepointTriggerID)
+	return f.GetSavepointStatusForTrigger(ctx, application, hash, jobID, application.Status.SavepointTriggerID)
+}
+
+func (f *Controller) GetSavepointStatusForTrigger(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error) {
+	return f.flinkClient.CheckSavepointStatus(ctx, f.getURLFromApp(application, hash), jobID, triggerID)
 }
 
 func (f *Controller) IsClusterReady(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
@@ -333,7 +475,11 @@ func (f *Controller) IsClusterReady(ctx context.Context, application *v1beta1.Fl
 		return false, nil
 	}
 
-	if deployments.Taskmanager.Status.AvailableReplicas < *deployments.Taskmanager.Spec.Replicas {
+	if statefulSet := deployments.TaskmanagerStatefulSet; statefulSet != nil {
+		if statefulSet.Status.ReadyReplicas < *statefulSet.Spec.Replicas {
+			return false, nil
+		}
+	} else if deployments.Taskmanager.Status.AvailableReplicas < *deployments.Taskmanager.Spec.Replicas {
 		return false, nil
 	}
 
@@ -348,7 +494,7 @@ func (f *Controller) IsServiceReady(ctx context.Context, application *v1beta1.Fl
 	}
 
 	// check that we have enough task slots to run the application
-	if resp.NumberOfTaskSlots < application.Spec.Parallelism {
+	if resp.NumberOfTaskSlots < GetParallelism(application) {
 		return false, nil
 	}
 
@@ -380,6 +526,19 @@ func listToFlinkDeployment(ds []v1.Deployment, hash string) *common.FlinkDeploym
 	return &fd
 }
 
+// Applications whose task managers run as a StatefulSet have a single (job manager) deployment for each hash
+func listToFlinkStatefulSetDeployment(ds []v1.Deployment, ss []v1.StatefulSet, hash string) *common.FlinkDeployment {
+	if len(ds) != 1 || len(ss) != 1 || !DeploymentIsJobmanager(&ds[0]) || !StatefulSetIsTaskmanager(&ss[0]) {
+		return nil
+	}
+
+	return &common.FlinkDeployment{
+		Jobmanager:             &ds[0],
+		TaskmanagerStatefulSet: &ss[0],
+		Hash:                   hash,
+	}
+}
+
 func getCurrentHash(app *v1beta1.FlinkApplication) string {
 	appHash := HashForApplication(app)
 
@@ -404,8 +563,16 @@ func (f *Controller) GetCurrentDeploymentsForApp(ctx context.Context, applicatio
 	}
 
 	cur := listToFlinkDeployment(deployments.Items, curHash)
+	if cur == nil && len(deployments.Items) == 1 {
+		statefulSets, err := f.k8Cluster.GetStatefulSetsWithLabel(ctx, application.Namespace, labels)
+		if err != nil {
+			return nil, err
+		}
+		cur = listToFlinkStatefulSetDeployment(deployments.Items, statefulSets.Items, curHash)
+	}
+
 	if cur != nil && application.Status.FailedDeployHash == "" && application.Status.TeardownHash == "" &&
-		(!f.deploymentMatches(ctx, cur.Jobmanager, application, curHash) || !f.deploymentMatches(ctx, cur.Taskmanager, application, curHash)) {
+		(!f.deploymentMatches(ctx, cur.Jobmanager, application, curHash) || !f.taskManagersMatch(ctx, cur, application, curHash)) {
 		// we had a hash collision (i.e., the previous application has the same hash as the new one)
 		// this is *very* unlikely to occur (1/2^32)
 		return nil, errors.New("found hash collision for deployment, you must do a clean deploy")
@@ -435,6 +602,29 @@ func (f *Controller) DeleteOldResourcesForApp(ctx context.Context, app *v1beta1.
 		}
 	}
 
+	statefulSets, err := f.getTaskManagerStatefulSets(ctx, app, appLabel, func(s *v1.StatefulSet) bool {
+		return s.Labels[FlinkAppHash] != "" &&
+			s.Labels[FlinkAppHash] != curHash &&
+			s.Name == fmt.Sprintf(TaskManagerNameFormat, app.Name, s.Labels[FlinkAppHash])
+	})
+	if err != nil {
+		return err
+	}
+	oldObjects = append(oldObjects, statefulSets...)
+
+	budgets, err := f.k8Cluster.GetPodDisruptionBudgetsWithLabel(ctx, app.Namespace, appLabel)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range budgets.Items {
+		if b.Labels[FlinkAppHash] != "" &&
+			b.Labels[FlinkAppHash] != curHash &&
+			PodDisruptionBudgetMatches(&b, app, b.Labels[FlinkAppHash]) {
+			oldObjects = append(oldObjects, b.DeepCopy())
+		}
+	}
+
 	services, err := f.k8Cluster.GetServicesWithLabel(ctx, app.Namespace, appLabel)
 	if err != nil {
 		return err
@@ -448,6 +638,14 @@ func (f *Controller) DeleteOldResourcesForApp(ctx context.Context, app *v1beta1.
 		}
 	}
 
+	configMaps, err := f.getHAConfigMaps(ctx, app, func(hash string) bool {
+		return hash != curHash
+	})
+	if err != nil {
+		return err
+	}
+	oldObjects = append(oldObjects, configMaps...)
+
 	deletedHashes := make(map[string]bool)
 
 	for _, resource := range oldObjects {
@@ -457,7 +655,9 @@ func (f *Controller) DeleteOldResourcesForApp(ctx context.Context, app *v1beta1.
 			return err
 		}
 		f.metrics.deleteResourceSuccessCounter.Inc(ctx)
-		deletedHashes[resource.GetLabels()[FlinkAppHash]] = true
+		if resourceHash, ok := resource.GetLabels()[FlinkAppHash]; ok {
+			deletedHashes[resourceHash] = true
+		}
 	}
 
 	for k := range deletedHashes {
@@ -468,6 +668,93 @@ func (f *Controller) DeleteOldResourcesForApp(ctx context.Context, app *v1beta1.
 	return nil
 }
 
+// Returns the task manager StatefulSets of the application that match the filter, along with their claims. Claims
+// created from the volumeClaimTemplates of a StatefulSet are not deleted with it, so we delete them ourselves. They
+// carry the labels of the StatefulSet's selector, and are named <claim>-<statefulset>-<ordinal>.
+func (f *Controller) getTaskManagerStatefulSets(ctx context.Context, app *v1beta1.FlinkApplication,
+	appLabel map[string]string, filter func(*v1.StatefulSet) bool) ([]metav1.Object, error) {
+	statefulSets, err := f.k8Cluster.GetStatefulSetsWithLabel(ctx, app.Namespace, appLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]metav1.Object, 0)
+	for _, s := range statefulSets.Items {
+		if !StatefulSetIsTaskmanager(&s) || !filter(&s) {
+			continue
+		}
+		objects = append(objects, s.DeepCopy())
+
+		if s.Spec.Selector == nil || len(s.Spec.VolumeClaimTemplates) == 0 {
+			continue
+		}
+		claims, err := f.k8Cluster.GetPersistentVolumeClaimsWithLabel(ctx, app.Namespace, s.Spec.Selector.MatchLabels)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range claims.Items {
+			if strings.Contains(c.Name, "-"+s.Name+"-") {
+				objects = append(objects, c.DeepCopy())
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+// Returns the HA ConfigMaps of the application whose hash matches the filter. These are created by Flink itself, so
+// they carry neither our labels nor an owner reference; we find them by the cluster id (<name>-<hash>) they are
+// labelled with instead.
+func (f *Controller) getHAConfigMaps(ctx context.Context, app *v1beta1.FlinkApplication,
+	filter func(hash string) bool) ([]metav1.Object, error) {
+	if app.Spec.HighAvailability == nil {
+		return nil, nil
+	}
+
+	configMaps, err := f.k8Cluster.GetConfigMapsWithLabel(ctx, app.Namespace, map[string]string{
+		HAConfigMapTypeLabel: HAConfigMapType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]metav1.Object, 0)
+	for _, c := range configMaps.Items {
+		clusterID := c.Labels[HAClusterIDLabel]
+		if !strings.HasPrefix(clusterID, app.Name+"-") {
+			continue
+		}
+		// hashes never contain a dash, so this skips the ConfigMaps of apps whose name starts with ours
+		hash := strings.TrimPrefix(clusterID, app.Name+"-")
+		if hash == "" || strings.Contains(hash, "-") || !filter(hash) {
+			continue
+		}
+		objects = append(objects, c.DeepCopy())
+	}
+
+	return objects, nil
+}
+
+func (f *Controller) DeleteHAConfigMapsForApp(ctx context.Context, app *v1beta1.FlinkApplication) error {
+	configMaps, err := f.getHAConfigMaps(ctx, app, func(string) bool {
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range configMaps {
+		err := f.k8Cluster.DeleteK8Object(ctx, c.(runtime.Object))
+		if err != nil {
+			f.metrics.deleteResourceFailedCounter.Inc(ctx)
+			return err
+		}
+		f.metrics.deleteResourceSuccessCounter.Inc(ctx)
+	}
+
+	return nil
+}
+
 func (f *Controller) FindExternalizedCheckpoint(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (string, error) {
 	checkpoint, err := f.flinkClient.GetLatestCheckpoint(ctx, f.getURLFromApp(application, hash), f.GetLatestJobID(ctx, application))
 	var checkpointPath string
@@ -529,7 +816,8 @@ func (f *Controller) CompareAndUpdateClusterStatus(ctx context.Context, applicat
 	}
 
 	application.Status.ClusterStatus.ClusterOverviewURL = f.getClusterOverviewURL(application, "")
-	application.Status.ClusterStatus.NumberOfTaskManagers = deployment.Taskmanager.Status.AvailableReplicas
+	tmReplicas, tmAvailable := deployment.TaskManagerStatus()
+	application.Status.ClusterStatus.NumberOfTaskManagers = tmAvailable
 	// Get Cluster overview
 	response, err := f.flinkClient.GetClusterOverview(ctx, f.getURLFromApp(application, hash))
 	if err != nil {
@@ -549,7 +837,7 @@ func (f *Controller) CompareAndUpdateClusterStatus(ctx context.Context, applicat
 	// Determine Health of the cluster.
 	// Healthy TaskManagers == Number of taskmanagers --> Green
 	// Else --> Yellow
-	if application.Status.ClusterStatus.HealthyTaskManagers == deployment.Taskmanager.Status.Replicas {
+	if application.Status.ClusterStatus.HealthyTaskManagers == tmReplicas {
 		application.Status.ClusterStatus.Health = v1beta1.Green
 	} else {
 		application.Status.ClusterStatus.Health = v1beta1.Yellow
@@ -573,7 +861,11 @@ func getHealthyTaskManagerCount(response *client.TaskManagersResponse) int32 {
 
 func (f *Controller) CompareAndUpdateJobStatus(ctx context.Context, app *v1beta1.FlinkApplication, hash string) (bool, error) {
 	if v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
-		return f.compareAndUpdateBlueGreenJobStatus(ctx, app)
+		changed, err := f.compareAndUpdateBlueGreenJobStatus(ctx, app)
+		if err == nil {
+			updateJobRunningCondition(app, f.GetLatestJobStatus(ctx, app))
+		}
+		return changed, err
 	}
 	if app.Status.JobStatus.LastFailingTime == nil {
 		initTime := metav1.NewTime(time.Time{})
@@ -657,9 +949,21 @@ func (f *Controller) CompareAndUpdateJobStatus(ctx context.Context, app *v1beta1
 		currTime := metav1.Now()
 		app.Status.JobStatus.LastFailingTime = &currTime
 	}
+	updateJobRunningCondition(app, app.Status.JobStatus)
 	return !apiequality.Semantic.DeepEqual(oldJobStatus, app.Status.JobStatus), err
 }
 
+// The JobRunning condition only changes together with the job state, so it does not need to be
+// considered when determining whether the job status has changed
+func updateJobRunningCondition(app *v1beta1.FlinkApplication, jobStatus v1beta1.FlinkJobStatus) {
+	if jobStatus.State == v1beta1.Running {
+		app.Status.SetCondition(v1beta1.ConditionJobRunning, corev1.ConditionTrue, "JobRunning", "")
+	} else {
+		app.Status.SetCondition(v1beta1.ConditionJobRunning, corev1.ConditionFalse, "JobNotRunning",
+			fmt.Sprintf("Job is in state %s", jobStatus.State))
+	}
+}
+
 // Only used with the BlueGreen DeploymentMode
 // A method to identify the current VersionStatus
 func getCurrentStatusIndex(app *v1beta1.FlinkApplication) int32 {
@@ -667,8 +971,11 @@ func getCurrentStatusIndex(app *v1beta1.FlinkApplication) int32 {
 	// 1. The application is a Running phase and there's only one job running
 	// 2. First deploy ever
 	// 3. When the savepoint is being taken on the existing job
+	// 4. When the application is being suspended, which only happens with a single job running
+	// 5. When a bounded job has finished or failed, which also only happens with a single job running
 	if v1beta1.IsRunningPhase(app.Status.Phase) || app.Status.DeployHash == "" ||
-		app.Status.Phase == v1beta1.FlinkApplicationSavepointing {
+		app.Status.Phase == v1beta1.FlinkApplicationSavepointing ||
+		app.Status.Phase == v1beta1.FlinkApplicationSuspending || v1beta1.IsFinishedPhase(app.Status.Phase) {
 		return 0
 	}
 
@@ -753,7 +1060,8 @@ func (f *Controller) compareAndUpdateBlueGreenClusterStatus(ctx context.Context,
 
 		version := string(application.Status.VersionStatuses[currIndex].Version)
 		application.Status.VersionStatuses[currIndex].ClusterStatus.ClusterOverviewURL = f.getClusterOverviewURL(application, version)
-		application.Status.VersionStatuses[currIndex].ClusterStatus.NumberOfTaskManagers = deployment.Taskmanager.Status.AvailableReplicas
+		tmReplicas, tmAvailable := deployment.TaskManagerStatus()
+		application.Status.VersionStatuses[currIndex].ClusterStatus.NumberOfTaskManagers = tmAvailable
 		// Get Cluster overview
 		response, err := f.flinkClient.GetClusterOverview(ctx, f.getURLFromApp(application, hash))
 		if err != nil {
@@ -773,7 +1081,7 @@ func (f *Controller) compareAndUpdateBlueGreenClusterStatus(ctx context.Context,
 		// Determine Health of the cluster.
 		// Healthy TaskManagers == Number of taskmanagers --> Green
 		// Else --> Yellow
-		if application.Status.VersionStatuses[currIndex].ClusterStatus.HealthyTaskManagers == deployment.Taskmanager.Status.Replicas {
+		if application.Status.VersionStatuses[currIndex].ClusterStatus.HealthyTaskManagers == tmReplicas {
 			application.Status.VersionStatuses[currIndex].ClusterStatus.Health = v1beta1.Green
 		} else {
 			application.Status.VersionStatuses[currIndex].ClusterStatus.Health = v1beta1.Yellow
@@ -896,14 +1204,39 @@ func (f *Controller) DeleteResourcesForAppWithHash(ctx context.Context, app *v1b
 	oldObjects := make([]metav1.Object, 0)
 
 	for _, d := range deployments.Items {
+		version := d.Labels[FlinkApplicationVersion]
 		if d.Labels[FlinkAppHash] == hash &&
-			// verify that this deployment matches the jobmanager or taskmanager naming format
-			(d.Name == fmt.Sprintf(JobManagerVersionNameFormat, app.Name, d.Labels[FlinkAppHash], d.Labels[FlinkApplicationVersion]) ||
-				d.Name == fmt.Sprintf(TaskManagerVersionNameFormat, app.Name, d.Labels[FlinkAppHash], d.Labels[FlinkApplicationVersion])) {
+			// verify that this deployment matches the jobmanager or taskmanager naming format, which only includes
+			// the version for BlueGreen deployments
+			(d.Name == fmt.Sprintf(JobManagerNameFormat, app.Name, hash) ||
+				d.Name == fmt.Sprintf(TaskManagerNameFormat, app.Name, hash) ||
+				d.Name == fmt.Sprintf(JobManagerVersionNameFormat, app.Name, hash, version) ||
+				d.Name == fmt.Sprintf(TaskManagerVersionNameFormat, app.Name, hash, version)) {
 			oldObjects = append(oldObjects, d.DeepCopy())
 		}
 	}
 
+	statefulSets, err := f.getTaskManagerStatefulSets(ctx, app, appLabel, func(s *v1.StatefulSet) bool {
+		return s.Labels[FlinkAppHash] == hash &&
+			(s.Name == fmt.Sprintf(TaskManagerNameFormat, app.Name, hash) ||
+				s.Name == fmt.Sprintf(TaskManagerVersionNameFormat, app.Name, hash, s.Labels[FlinkApplicationVersion]))
+	})
+	if err != nil {
+		return err
+	}
+	oldObjects = append(oldObjects, statefulSets...)
+
+	budgets, err := f.k8Cluster.GetPodDisruptionBudgetsWithLabel(ctx, app.Namespace, appLabel)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range budgets.Items {
+		if b.Labels[FlinkAppHash] == hash && PodDisruptionBudgetMatches(&b, app, hash) {
+			oldObjects = append(oldObjects, b.DeepCopy())
+		}
+	}
+
 	services, err := f.k8Cluster.GetServicesWithLabel(ctx, app.Namespace, appLabel)
 
 	if err != nil {
@@ -916,6 +1249,21 @@ func (f *Controller) DeleteResourcesForAppWithHash(ctx context.Context, app *v1b
 		}
 	}
 
+	if app.Spec.HighAvailability != nil {
+		// the HA ConfigMaps are created by Flink itself, so they carry neither our labels nor an owner reference
+		configMaps, err := f.k8Cluster.GetConfigMapsWithLabel(ctx, app.Namespace, map[string]string{
+			HAClusterIDLabel:     getHAClusterID(app, hash),
+			HAConfigMapTypeLabel: HAConfigMapType,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range configMaps.Items {
+			oldObjects = append(oldObjects, c.DeepCopy())
+		}
+	}
+
 	deletedHashes := make(map[string]bool)
 
 	for _, resource := range oldObjects {
@@ -925,7 +1273,9 @@ func (f *Controller) DeleteResourcesForAppWithHash(ctx context.Context, app *v1b
 			return err
 		}
 		f.metrics.deleteResourceSuccessCounter.Inc(ctx)
-		deletedHashes[resource.GetLabels()[FlinkAppHash]] = true
+		if resourceHash, ok := resource.GetLabels()[FlinkAppHash]; ok {
+			deletedHashes[resourceHash] = true
+		}
 	}
 
 	for k := range deletedHashes {
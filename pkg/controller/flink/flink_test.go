@@ -23,9 +23,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const testImage = "123.xyz.com/xx:11ae1218924428faabd9b64423fa0c332efba6b2"
@@ -327,6 +331,7 @@ func TestDeleteOldResources(t *testing.T) {
 	service := FetchJobManagerServiceCreateObj(&app, "oldhash")
 	service.Labels[FlinkAppHash] = "oldhash"
 	service.Name = VersionedJobManagerServiceName(&app, "oldhash")
+	budget := FetchPodDisruptionBudgetCreateObj(&app, "oldhash")
 
 	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
 
@@ -343,6 +348,16 @@ func TestDeleteOldResources(t *testing.T) {
 		}, nil
 	}
 
+	mockK8Cluster.GetPodDisruptionBudgetsWithLabelFunc = func(ctx context.Context, namespace string,
+		labelMap map[string]string) (*policyv1beta1.PodDisruptionBudgetList, error) {
+		return &policyv1beta1.PodDisruptionBudgetList{
+			Items: []policyv1beta1.PodDisruptionBudget{
+				*budget,
+				*FetchPodDisruptionBudgetCreateObj(&app, testAppHash),
+			},
+		}, nil
+	}
+
 	mockK8Cluster.GetServicesWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error) {
 		curService := FetchJobManagerServiceCreateObj(&app, testAppHash)
 		curService.Labels[FlinkAppHash] = testAppHash
@@ -367,14 +382,196 @@ func TestDeleteOldResources(t *testing.T) {
 		case 2:
 			assert.Equal(t, tmDeployment, object)
 		case 3:
+			assert.Equal(t, budget, object)
+		case 4:
 			assert.Equal(t, service, object)
 		}
 		return nil
 	}
 
 	err := flinkControllerForTest.DeleteOldResourcesForApp(context.Background(), &app)
-	assert.Equal(t, 3, ctr)
+	assert.Equal(t, 4, ctr)
+	assert.Nil(t, err)
+}
+
+func getHAConfigMap(app *v1beta1.FlinkApplication, clusterID string) corev1.ConfigMap {
+	return corev1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      clusterID + "-dispatcher-leader",
+			Namespace: app.Namespace,
+			Labels: map[string]string{
+				"app":            clusterID,
+				"configmap-type": "high-availability",
+			},
+		},
+	}
+}
+
+func TestDeleteOldResourcesWithHighAvailability(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getFlinkTestApp()
+	app.Spec.HighAvailability = &v1beta1.HighAvailabilityConfig{StorageDir: "s3://flink/ha"}
+
+	oldConfigMap := getHAConfigMap(&app, "app-name-oldhash")
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetDeploymentsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error) {
+		return &v1.DeploymentList{}, nil
+	}
+	mockK8Cluster.GetServicesWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error) {
+		return &corev1.ServiceList{}, nil
+	}
+	mockK8Cluster.GetConfigMapsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ConfigMapList, error) {
+		assert.Equal(t, app.Namespace, namespace)
+		assert.Equal(t, map[string]string{"configmap-type": "high-availability"}, labelMap)
+		return &corev1.ConfigMapList{Items: []corev1.ConfigMap{
+			oldConfigMap,
+			getHAConfigMap(&app, "app-name-"+testAppHash),
+			// belongs to another application whose name starts with ours
+			getHAConfigMap(&app, "app-name-other-oldhash"),
+		}}, nil
+	}
+
+	ctr := 0
+	mockK8Cluster.DeleteK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		ctr++
+		assert.Equal(t, &oldConfigMap, object)
+		return nil
+	}
+
+	err := flinkControllerForTest.DeleteOldResourcesForApp(context.Background(), &app)
+	assert.Equal(t, 1, ctr)
+	assert.Nil(t, err)
+}
+
+func TestDeleteHAConfigMapsForApp(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getFlinkTestApp()
+	app.Spec.HighAvailability = &v1beta1.HighAvailabilityConfig{StorageDir: "s3://flink/ha"}
+
+	oldConfigMap := getHAConfigMap(&app, "app-name-oldhash")
+	curConfigMap := getHAConfigMap(&app, "app-name-"+testAppHash)
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetConfigMapsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ConfigMapList, error) {
+		return &corev1.ConfigMapList{Items: []corev1.ConfigMap{
+			oldConfigMap,
+			curConfigMap,
+			getHAConfigMap(&app, "app-name-other-oldhash"),
+		}}, nil
+	}
+
+	ctr := 0
+	mockK8Cluster.DeleteK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		ctr++
+		switch ctr {
+		case 1:
+			assert.Equal(t, &oldConfigMap, object)
+		case 2:
+			assert.Equal(t, &curConfigMap, object)
+		}
+		return nil
+	}
+
+	err := flinkControllerForTest.DeleteHAConfigMapsForApp(context.Background(), &app)
+	assert.Equal(t, 2, ctr)
+	assert.Nil(t, err)
+}
+
+func getStatefulSetTestApp() v1beta1.FlinkApplication {
+	app := getFlinkTestApp()
+	app.Spec.TaskManagerConfig.StatefulSet = &v1beta1.TaskManagerStatefulSetConfig{
+		VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+			{ObjectMeta: metaV1.ObjectMeta{Name: "local-state"}},
+		},
+	}
+	return app
+}
+
+func TestFlinkIsClusterReadyStatefulSet(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	flinkApp := getStatefulSetTestApp()
+	hash := HashForApplication(&flinkApp)
+
+	statefulSet := FetchTaskManagerStatefulSetCreateObj(&flinkApp, hash)
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetDeploymentsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error) {
+		jmDeployment := FetchJobMangerDeploymentCreateObj(&flinkApp, hash)
+		jmDeployment.Status.AvailableReplicas = 1
+		return &v1.DeploymentList{Items: []v1.Deployment{*jmDeployment}}, nil
+	}
+	mockK8Cluster.GetStatefulSetsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.StatefulSetList, error) {
+		assert.Equal(t, testNamespace, namespace)
+		assert.Equal(t, hash, labelMap[FlinkAppHash])
+		return &v1.StatefulSetList{Items: []v1.StatefulSet{*statefulSet}}, nil
+	}
+
+	cur, err := flinkControllerForTest.GetCurrentDeploymentsForApp(context.Background(), &flinkApp)
+	assert.Nil(t, err)
+	assert.Nil(t, cur.Taskmanager)
+	assert.Equal(t, statefulSet.Name, cur.TaskmanagerStatefulSet.Name)
+
+	result, err := flinkControllerForTest.IsClusterReady(context.Background(), &flinkApp)
+	assert.False(t, result)
+	assert.Nil(t, err)
+
+	statefulSet.Status.ReadyReplicas = *statefulSet.Spec.Replicas
+	result, err = flinkControllerForTest.IsClusterReady(context.Background(), &flinkApp)
+	assert.True(t, result)
+	assert.Nil(t, err)
+}
+
+func TestDeleteOldResourcesStatefulSet(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getStatefulSetTestApp()
+	hash := HashForApplication(&app)
+
+	oldStatefulSet := FetchTaskManagerStatefulSetCreateObj(&app, "oldhash")
+	oldClaim := corev1.PersistentVolumeClaim{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:   "local-state-" + oldStatefulSet.Name + "-0",
+			Labels: oldStatefulSet.Spec.Selector.MatchLabels,
+		},
+	}
+	// not created from the StatefulSet, so it is left alone
+	otherClaim := corev1.PersistentVolumeClaim{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:   "other",
+			Labels: oldStatefulSet.Spec.Selector.MatchLabels,
+		},
+	}
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetDeploymentsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error) {
+		return &v1.DeploymentList{}, nil
+	}
+	mockK8Cluster.GetServicesWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error) {
+		return &corev1.ServiceList{}, nil
+	}
+	mockK8Cluster.GetStatefulSetsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.StatefulSetList, error) {
+		return &v1.StatefulSetList{
+			Items: []v1.StatefulSet{
+				*oldStatefulSet,
+				*FetchTaskManagerStatefulSetCreateObj(&app, hash),
+			},
+		}, nil
+	}
+	mockK8Cluster.GetPersistentVolumeClaimsWithLabelFunc = func(ctx context.Context, namespace string,
+		labelMap map[string]string) (*corev1.PersistentVolumeClaimList, error) {
+		assert.Equal(t, "oldhash", labelMap[FlinkAppHash])
+		assert.Equal(t, FlinkDeploymentTypeTaskmanager, labelMap[FlinkDeploymentType])
+		return &corev1.PersistentVolumeClaimList{Items: []corev1.PersistentVolumeClaim{oldClaim, otherClaim}}, nil
+	}
+
+	var deleted []runtime.Object
+	mockK8Cluster.DeleteK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		deleted = append(deleted, object)
+		return nil
+	}
+
+	err := flinkControllerForTest.DeleteOldResourcesForApp(context.Background(), &app)
 	assert.Nil(t, err)
+	assert.Equal(t, []runtime.Object{oldStatefulSet, &oldClaim}, deleted)
 }
 
 func TestCreateCluster(t *testing.T) {
@@ -389,8 +586,149 @@ func TestCreateCluster(t *testing.T) {
 	mockTaskManager.CreateIfNotExistFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
 		return true, nil
 	}
+	var created []runtime.Object
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.CreateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		created = append(created, object)
+		return nil
+	}
 	err := flinkControllerForTest.CreateCluster(context.Background(), &flinkApp)
 	assert.Nil(t, err)
+	assert.Equal(t, []runtime.Object{FetchPodDisruptionBudgetCreateObj(&flinkApp, HashForApplication(&flinkApp))}, created)
+
+	// a budget left over from an earlier attempt is kept
+	mockK8Cluster.CreateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		return k8sErrors.NewAlreadyExists(schema.GroupResource{}, "")
+	}
+	err = flinkControllerForTest.CreateCluster(context.Background(), &flinkApp)
+	assert.Nil(t, err)
+}
+
+func TestUpdatePodDisruptionBudget(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getFlinkTestApp()
+	existing := FetchPodDisruptionBudgetCreateObj(&app, testAppHash)
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetPodDisruptionBudgetsWithLabelFunc = func(ctx context.Context, namespace string,
+		labelMap map[string]string) (*policyv1beta1.PodDisruptionBudgetList, error) {
+		assert.Equal(t, testAppHash, labelMap[FlinkAppHash])
+		return &policyv1beta1.PodDisruptionBudgetList{Items: []policyv1beta1.PodDisruptionBudget{*existing}}, nil
+	}
+	mockK8Cluster.CreateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		assert.False(t, true)
+		return nil
+	}
+	var updated []runtime.Object
+	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		updated = append(updated, object)
+		return nil
+	}
+
+	// the budget already matches the application
+	created, err := flinkControllerForTest.UpdatePodDisruptionBudget(context.Background(), &app, testAppHash)
+	assert.Nil(t, err)
+	assert.False(t, created)
+	assert.Empty(t, updated)
+
+	maxUnavailable := intstr.FromString("50%")
+	app.Spec.PodDisruptionBudget = &v1beta1.DisruptionBudgetConfig{MaxUnavailable: &maxUnavailable}
+	created, err = flinkControllerForTest.UpdatePodDisruptionBudget(context.Background(), &app, testAppHash)
+	assert.Nil(t, err)
+	assert.False(t, created)
+	assert.Equal(t, 1, len(updated))
+	budget := updated[0].(*policyv1beta1.PodDisruptionBudget)
+	assert.Equal(t, existing.Name, budget.Name)
+	assert.Equal(t, maxUnavailable, *budget.Spec.MaxUnavailable)
+}
+
+func TestUpdatePodDisruptionBudgetCreatesMissing(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getFlinkTestApp()
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	var created []runtime.Object
+	mockK8Cluster.CreateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		created = append(created, object)
+		return nil
+	}
+
+	isCreated, err := flinkControllerForTest.UpdatePodDisruptionBudget(context.Background(), &app, testAppHash)
+	assert.Nil(t, err)
+	assert.True(t, isCreated)
+	assert.Equal(t, []runtime.Object{FetchPodDisruptionBudgetCreateObj(&app, testAppHash)}, created)
+}
+
+func TestUpdatePodDisruptionBudgetWithStandbyJobManagers(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getFlinkTestApp()
+	app.Spec.HighAvailability = &v1beta1.HighAvailabilityConfig{StorageDir: "s3://flink/ha"}
+	replicas := int32(2)
+	app.Spec.JobManagerConfig.Replicas = &replicas
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	// the budget of the task managers already exists
+	mockK8Cluster.GetPodDisruptionBudgetsWithLabelFunc = func(ctx context.Context, namespace string,
+		labelMap map[string]string) (*policyv1beta1.PodDisruptionBudgetList, error) {
+		return &policyv1beta1.PodDisruptionBudgetList{Items: []policyv1beta1.PodDisruptionBudget{
+			*FetchPodDisruptionBudgetCreateObj(&app, testAppHash),
+		}}, nil
+	}
+	var created []runtime.Object
+	mockK8Cluster.CreateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		created = append(created, object)
+		return nil
+	}
+
+	isCreated, err := flinkControllerForTest.UpdatePodDisruptionBudget(context.Background(), &app, testAppHash)
+	assert.Nil(t, err)
+	assert.True(t, isCreated)
+	assert.Equal(t, []runtime.Object{FetchJobManagerPodDisruptionBudgetCreateObj(&app, testAppHash)}, created)
+}
+
+func TestRestartJobManagerFromSavepoint(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getFlinkTestApp()
+	app.Spec.ExecutionMode = v1beta1.ExecutionModeApplication
+	app.Spec.EntryClass = testEntryClass
+	jobManager := FetchJobMangerDeploymentCreateObj(&app, testAppHash)
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetDeploymentsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error) {
+		assert.Equal(t, testAppHash, labelMap[FlinkAppHash])
+		return &v1.DeploymentList{Items: []v1.Deployment{
+			*FetchTaskMangerDeploymentCreateObj(&app, testAppHash),
+			*jobManager,
+		}}, nil
+	}
+	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		jobManager = object.(*v1.Deployment)
+		return nil
+	}
+
+	restarted, err := flinkControllerForTest.RestartJobManagerFromSavepoint(context.Background(), &app, testAppHash,
+		"s3://savepoints/1")
+	assert.Nil(t, err)
+	assert.True(t, restarted)
+	assert.Equal(t, []string{"standalone-job", "--fromSavepoint", "s3://savepoints/1", "--job-classname", testEntryClass},
+		jobManager.Spec.Template.Spec.Containers[0].Args)
+
+	// the job manager is only restarted once
+	restarted, err = flinkControllerForTest.RestartJobManagerFromSavepoint(context.Background(), &app, testAppHash,
+		"s3://savepoints/1")
+	assert.Nil(t, err)
+	assert.False(t, restarted)
+
+	restarted, err = flinkControllerForTest.RestartJobManagerFromSavepoint(context.Background(), &app, testAppHash,
+		"s3://savepoints/2")
+	assert.Nil(t, err)
+	assert.True(t, restarted)
+	assert.Equal(t, []string{"standalone-job", "--fromSavepoint", "s3://savepoints/2", "--job-classname", testEntryClass},
+		jobManager.Spec.Template.Spec.Containers[0].Args)
+
+	_, err = flinkControllerForTest.RestartJobManagerFromSavepoint(context.Background(), &app, "other-hash",
+		"s3://savepoints/1")
+	assert.NotNil(t, err)
 }
 
 func TestCreateClusterJmErr(t *testing.T) {
@@ -846,6 +1184,7 @@ func TestJobStatusUpdated(t *testing.T) {
 
 	assert.Equal(t, int32(2), flinkApp.Status.JobStatus.RunningTasks)
 	assert.Equal(t, int32(7), flinkApp.Status.JobStatus.TotalTasks)
+	assert.Equal(t, corev1.ConditionTrue, flinkApp.Status.GetCondition(v1beta1.ConditionJobRunning).Status)
 
 }
 
@@ -987,12 +1326,16 @@ func TestGetCurrentStatusIndex(t *testing.T) {
 	app.Status.Phase = v1beta1.FlinkApplicationSubmittingJob
 	assert.Equal(t, int32(0), getCurrentStatusIndex(&app))
 
-	// Subsequent deploys return 0 when in Running or Savepointing phase
+	// Subsequent deploys return 0 when in Running, Savepointing, Suspending or a finished phase
 	app.Status.DeployHash = "hash"
 	statuses := make([]v1beta1.FlinkApplicationVersionStatus, 2)
 	app.Status.VersionStatuses = statuses
 	app.Status.Phase = v1beta1.FlinkApplicationSavepointing
 	assert.Equal(t, int32(0), getCurrentStatusIndex(&app))
+	app.Status.Phase = v1beta1.FlinkApplicationSuspending
+	assert.Equal(t, int32(0), getCurrentStatusIndex(&app))
+	app.Status.Phase = v1beta1.FlinkApplicationSucceeded
+	assert.Equal(t, int32(0), getCurrentStatusIndex(&app))
 	app.Status.Phase = v1beta1.FlinkApplicationRunning
 	assert.Equal(t, int32(0), getCurrentStatusIndex(&app))
 	// Else return 1
@@ -1060,6 +1403,7 @@ func TestDeleteResourcesForAppWithHash(t *testing.T) {
 	service.Name = VersionedJobManagerServiceName(&app, "oldhash")
 	genericService := FetchJobManagerServiceCreateObj(&app, "oldhash")
 	genericService.Name = app.Name
+	budget := FetchPodDisruptionBudgetCreateObj(&app, "oldhash")
 
 	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
 
@@ -1076,6 +1420,16 @@ func TestDeleteResourcesForAppWithHash(t *testing.T) {
 		}, nil
 	}
 
+	mockK8Cluster.GetPodDisruptionBudgetsWithLabelFunc = func(ctx context.Context, namespace string,
+		labelMap map[string]string) (*policyv1beta1.PodDisruptionBudgetList, error) {
+		return &policyv1beta1.PodDisruptionBudgetList{
+			Items: []policyv1beta1.PodDisruptionBudget{
+				*budget,
+				*FetchPodDisruptionBudgetCreateObj(&app, testAppHash),
+			},
+		}, nil
+	}
+
 	mockK8Cluster.GetServicesWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error) {
 		curService := FetchJobManagerServiceCreateObj(&app, testAppHash)
 		curService.Labels[FlinkAppHash] = testAppHash
@@ -1100,8 +1454,10 @@ func TestDeleteResourcesForAppWithHash(t *testing.T) {
 		case 2:
 			assert.Equal(t, tmDeployment, object)
 		case 3:
-			assert.Equal(t, service, object)
+			assert.Equal(t, budget, object)
 		case 4:
+			assert.Equal(t, service, object)
+		case 5:
 			assert.Equal(t, genericService, object)
 
 		}
@@ -1109,6 +1465,122 @@ func TestDeleteResourcesForAppWithHash(t *testing.T) {
 	}
 
 	err := flinkControllerForTest.DeleteResourcesForAppWithHash(context.Background(), &app, "oldhash")
-	assert.Equal(t, 3, ctr)
+	assert.Equal(t, 4, ctr)
+	assert.Nil(t, err)
+}
+
+func TestDeleteResourcesForAppWithHashDualMode(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getFlinkTestApp()
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeDual
+	app.Status.DeploymentMode = v1beta1.DeploymentModeDual
+	jmDeployment := FetchJobMangerDeploymentCreateObj(&app, "oldhash")
+	tmDeployment := FetchTaskMangerDeploymentCreateObj(&app, "oldhash")
+	service := FetchJobManagerServiceCreateObj(&app, "oldhash")
+	service.Labels[FlinkAppHash] = "oldhash"
+	service.Name = VersionedJobManagerServiceName(&app, "oldhash")
+	budget := FetchPodDisruptionBudgetCreateObj(&app, "oldhash")
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetDeploymentsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error) {
+		return &v1.DeploymentList{
+			Items: []v1.Deployment{
+				*jmDeployment,
+				*tmDeployment,
+				*FetchJobMangerDeploymentCreateObj(&app, testAppHash),
+				*FetchTaskMangerDeploymentCreateObj(&app, testAppHash),
+			},
+		}, nil
+	}
+	mockK8Cluster.GetPodDisruptionBudgetsWithLabelFunc = func(ctx context.Context, namespace string,
+		labelMap map[string]string) (*policyv1beta1.PodDisruptionBudgetList, error) {
+		return &policyv1beta1.PodDisruptionBudgetList{Items: []policyv1beta1.PodDisruptionBudget{*budget}}, nil
+	}
+	mockK8Cluster.GetServicesWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error) {
+		return &corev1.ServiceList{Items: []corev1.Service{*service}}, nil
+	}
+
+	var deleted []runtime.Object
+	mockK8Cluster.DeleteK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		deleted = append(deleted, object)
+		return nil
+	}
+
+	err := flinkControllerForTest.DeleteResourcesForAppWithHash(context.Background(), &app, "oldhash")
+	assert.Nil(t, err)
+	assert.Equal(t, []runtime.Object{jmDeployment, tmDeployment, budget, service}, deleted)
+}
+
+func TestDeleteResourcesForAppWithHashDualModeStatefulSet(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getStatefulSetTestApp()
+	app.Status.DeploymentMode = v1beta1.DeploymentModeDual
+	jmDeployment := FetchJobMangerDeploymentCreateObj(&app, "oldhash")
+	statefulSet := FetchTaskManagerStatefulSetCreateObj(&app, "oldhash")
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetDeploymentsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error) {
+		return &v1.DeploymentList{Items: []v1.Deployment{*jmDeployment}}, nil
+	}
+	mockK8Cluster.GetStatefulSetsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.StatefulSetList, error) {
+		return &v1.StatefulSetList{
+			Items: []v1.StatefulSet{
+				*statefulSet,
+				*FetchTaskManagerStatefulSetCreateObj(&app, testAppHash),
+			},
+		}, nil
+	}
+	mockK8Cluster.GetServicesWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error) {
+		return &corev1.ServiceList{}, nil
+	}
+
+	var deleted []runtime.Object
+	mockK8Cluster.DeleteK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		deleted = append(deleted, object)
+		return nil
+	}
+
+	err := flinkControllerForTest.DeleteResourcesForAppWithHash(context.Background(), &app, "oldhash")
+	assert.Nil(t, err)
+	assert.Equal(t, []runtime.Object{jmDeployment, statefulSet}, deleted)
+}
+
+func TestDeleteResourcesForAppWithHashWithHighAvailability(t *testing.T) {
+	flinkControllerForTest := getTestFlinkController()
+	app := getFlinkTestApp()
+	app.Spec.HighAvailability = &v1beta1.HighAvailabilityConfig{StorageDir: "s3://flink/ha"}
+	haConfigMap := corev1.ConfigMap{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      "app-name-oldhash-dispatcher-leader",
+			Namespace: app.Namespace,
+			Labels: map[string]string{
+				"app":            "app-name-oldhash",
+				"configmap-type": "high-availability",
+			},
+		},
+	}
+
+	mockK8Cluster := flinkControllerForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetDeploymentsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error) {
+		return &v1.DeploymentList{}, nil
+	}
+	mockK8Cluster.GetServicesWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error) {
+		return &corev1.ServiceList{}, nil
+	}
+	mockK8Cluster.GetConfigMapsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ConfigMapList, error) {
+		assert.Equal(t, app.Namespace, namespace)
+		assert.Equal(t, haConfigMap.Labels, labelMap)
+		return &corev1.ConfigMapList{Items: []corev1.ConfigMap{haConfigMap}}, nil
+	}
+
+	ctr := 0
+	mockK8Cluster.DeleteK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		ctr++
+		assert.Equal(t, &haConfigMap, object)
+		return nil
+	}
+
+	err := flinkControllerForTest.DeleteResourcesForAppWithHash(context.Background(), &app, "oldhash")
+	assert.Equal(t, 1, ctr)
 	assert.Nil(t, err)
 }
@@ -0,0 +1,128 @@
+package flink
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A HealthCheck decides whether a freshly deployed version of an application is unhealthy, based on the status of
+// its job and cluster, and on what the operator has observed of them since the version was deployed
+type HealthCheck interface {
+	// A short name for the check, which is recorded in the status when it fails
+	Name() string
+	// Returns a description of the problem if the version is unhealthy, or an empty string otherwise
+	Check(app *v1beta1.FlinkApplication, status *v1beta1.AutoRollbackStatus, now time.Time) string
+}
+
+// Returns the built-in health checks, configured by the auto-rollback policy
+func NewHealthChecks(policy *v1beta1.AutoRollbackPolicy) []HealthCheck {
+	return []HealthCheck{
+		restartRateCheck{
+			maxRestarts: int32OrDefault(policy.MaxJobRestarts, v1beta1.DefaultAutoRollbackMaxJobRestarts),
+		},
+		checkpointFailureCheck{
+			maxFailures: int32OrDefault(policy.MaxConsecutiveFailedCheckpoints,
+				v1beta1.DefaultAutoRollbackMaxConsecutiveFailedCheckpoints),
+		},
+		taskManagerHeartbeatCheck{
+			timeout: durationOrDefault(policy.TaskManagerHeartbeatTimeout,
+				v1beta1.DefaultAutoRollbackTaskManagerHeartbeatTimeout),
+		},
+	}
+}
+
+func GetAutoRollbackWindow(policy *v1beta1.AutoRollbackPolicy) time.Duration {
+	return durationOrDefault(policy.Window, v1beta1.DefaultAutoRollbackWindow)
+}
+
+func durationOrDefault(value *metav1.Duration, defaultValue time.Duration) time.Duration {
+	if value == nil {
+		return defaultValue
+	}
+	return value.Duration
+}
+
+// Records the progress of the job and the cluster in the status, for the checks that look at more than the latest
+// observation. The counts of the job are only known to belong to the new version once it has been observed running,
+// so the first observation is the baseline for the checks.
+func ObserveHealth(app *v1beta1.FlinkApplication, status *v1beta1.AutoRollbackStatus, now time.Time) {
+	job := app.Status.JobStatus
+	if status.InitialJobRestartCount == nil {
+		restarts := job.JobRestartCount
+		status.InitialJobRestartCount = &restarts
+		status.CompletedCheckpointCount = job.CompletedCheckpointCount
+		status.FailedCheckpointCount = job.FailedCheckpointCount
+	} else if job.CompletedCheckpointCount > status.CompletedCheckpointCount {
+		status.CompletedCheckpointCount = job.CompletedCheckpointCount
+		status.FailedCheckpointCount = job.FailedCheckpointCount
+	}
+
+	if app.Status.ClusterStatus.HealthyTaskManagers < computeTaskManagerReplicas(app) {
+		if status.UnhealthyTaskManagersSince == nil {
+			since := metav1.NewTime(now)
+			status.UnhealthyTaskManagersSince = &since
+		}
+	} else {
+		status.UnhealthyTaskManagersSince = nil
+	}
+}
+
+// Fails when the job has restarted too often since the version was deployed
+type restartRateCheck struct {
+	maxRestarts int32
+}
+
+func (c restartRateCheck) Name() string {
+	return "RestartRate"
+}
+
+func (c restartRateCheck) Check(app *v1beta1.FlinkApplication, status *v1beta1.AutoRollbackStatus, now time.Time) string {
+	if status.InitialJobRestartCount == nil {
+		return ""
+	}
+	restarts := app.Status.JobStatus.JobRestartCount - *status.InitialJobRestartCount
+	if restarts > c.maxRestarts {
+		return fmt.Sprintf("the job restarted %d times since it was deployed, more than the allowed %d",
+			restarts, c.maxRestarts)
+	}
+	return ""
+}
+
+// Fails when too many checkpoints have failed since the last one completed
+type checkpointFailureCheck struct {
+	maxFailures int32
+}
+
+func (c checkpointFailureCheck) Name() string {
+	return "CheckpointFailures"
+}
+
+func (c checkpointFailureCheck) Check(app *v1beta1.FlinkApplication, status *v1beta1.AutoRollbackStatus, now time.Time) string {
+	failures := app.Status.JobStatus.FailedCheckpointCount - status.FailedCheckpointCount
+	if failures > c.maxFailures {
+		return fmt.Sprintf("%d checkpoints failed in a row, more than the allowed %d", failures, c.maxFailures)
+	}
+	return ""
+}
+
+// Fails when some of the task managers have not been sending heartbeats for too long
+type taskManagerHeartbeatCheck struct {
+	timeout time.Duration
+}
+
+func (c taskManagerHeartbeatCheck) Name() string {
+	return "TaskManagerHeartbeat"
+}
+
+func (c taskManagerHeartbeatCheck) Check(app *v1beta1.FlinkApplication, status *v1beta1.AutoRollbackStatus, now time.Time) string {
+	since := status.UnhealthyTaskManagersSince
+	if since != nil && now.Sub(since.Time) > c.timeout {
+		replicas := computeTaskManagerReplicas(app)
+		return fmt.Sprintf("%d of %d task managers have not been sending heartbeats for more than %s",
+			replicas-app.Status.ClusterStatus.HealthyTaskManagers, replicas, c.timeout)
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package flink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func runHealthChecks(app *v1beta1.FlinkApplication, status *v1beta1.AutoRollbackStatus, now time.Time) map[string]string {
+	failures := map[string]string{}
+	for _, check := range NewHealthChecks(app.Spec.AutoRollback) {
+		if reason := check.Check(app, status, now); reason != "" {
+			failures[check.Name()] = reason
+		}
+	}
+	return failures
+}
+
+func TestHealthChecks(t *testing.T) {
+	now := time.Date(2019, 9, 1, 10, 0, 0, 0, time.UTC)
+	app := getFlinkTestApp()
+	app.Spec.AutoRollback = &v1beta1.AutoRollbackPolicy{}
+	app.Status.ClusterStatus.HealthyTaskManagers = 1
+	app.Status.JobStatus.JobRestartCount = 1
+	status := &v1beta1.AutoRollbackStatus{}
+
+	ObserveHealth(&app, status, now)
+	assert.Equal(t, int32(1), *status.InitialJobRestartCount)
+	assert.Empty(t, runHealthChecks(&app, status, now))
+
+	app.Status.JobStatus.JobRestartCount = 5
+	assert.Equal(t, map[string]string{
+		"RestartRate": "the job restarted 4 times since it was deployed, more than the allowed 3",
+	}, runHealthChecks(&app, status, now))
+}
+
+func TestCheckpointFailureCheck(t *testing.T) {
+	now := time.Date(2019, 9, 1, 10, 0, 0, 0, time.UTC)
+	app := getFlinkTestApp()
+	app.Spec.AutoRollback = &v1beta1.AutoRollbackPolicy{}
+	app.Status.ClusterStatus.HealthyTaskManagers = 1
+	status := &v1beta1.AutoRollbackStatus{}
+
+	// the counts at the first observation are the baseline
+	app.Status.JobStatus.CompletedCheckpointCount = 1
+	app.Status.JobStatus.FailedCheckpointCount = 2
+	ObserveHealth(&app, status, now)
+	assert.Empty(t, runHealthChecks(&app, status, now))
+
+	app.Status.JobStatus.CompletedCheckpointCount = 2
+	app.Status.JobStatus.FailedCheckpointCount = 3
+	ObserveHealth(&app, status, now)
+	// the failures before the last completed checkpoint are not counted
+	assert.Empty(t, runHealthChecks(&app, status, now))
+
+	app.Status.JobStatus.FailedCheckpointCount = 6
+	ObserveHealth(&app, status, now)
+	assert.Empty(t, runHealthChecks(&app, status, now))
+
+	app.Status.JobStatus.FailedCheckpointCount = 7
+	ObserveHealth(&app, status, now)
+	assert.Equal(t, map[string]string{
+		"CheckpointFailures": "4 checkpoints failed in a row, more than the allowed 3",
+	}, runHealthChecks(&app, status, now))
+}
+
+func TestTaskManagerHeartbeatCheck(t *testing.T) {
+	now := time.Date(2019, 9, 1, 10, 0, 0, 0, time.UTC)
+	app := getFlinkTestApp()
+	app.Spec.AutoRollback = &v1beta1.AutoRollbackPolicy{}
+	status := &v1beta1.AutoRollbackStatus{}
+
+	ObserveHealth(&app, status, now)
+	assert.Equal(t, now, status.UnhealthyTaskManagersSince.Time)
+	assert.Empty(t, runHealthChecks(&app, status, now.Add(time.Minute)))
+
+	// the time is kept while the task managers remain unhealthy
+	ObserveHealth(&app, status, now.Add(3*time.Minute))
+	assert.Equal(t, map[string]string{
+		"TaskManagerHeartbeat": "1 of 1 task managers have not been sending heartbeats for more than 2m0s",
+	}, runHealthChecks(&app, status, now.Add(3*time.Minute)))
+
+	app.Status.ClusterStatus.HealthyTaskManagers = 1
+	ObserveHealth(&app, status, now.Add(4*time.Minute))
+	assert.Nil(t, status.UnhealthyTaskManagersSince)
+}
@@ -0,0 +1,139 @@
+package flink
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/controller/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	gcsEndpoint        = "https://storage.googleapis.com"
+	jarDownloadTimeout = 5 * time.Minute
+	maxJarSize         = 512 * 1024 * 1024
+)
+
+// Redirects are not followed, as they could point the operator at a host outside of the allowlist
+var jarDownloadClient = &http.Client{
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// Returns whether the operator config allows downloading jars from the host (or bucket) of the URI. Entries of the
+// allowlist are scheme://host URIs, so that "https://artifacts.example.com" allows every jar on that host and
+// "s3://bucket" every jar in that bucket.
+func isJarURIAllowed(u *url.URL) bool {
+	for _, entry := range config.GetConfig().JarURIAllowlist {
+		allowed, err := url.Parse(entry)
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(allowed.Scheme, u.Scheme) && strings.EqualFold(allowed.Host, u.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the URL a jar URI is downloaded from. Object store URIs are mapped to the path-style HTTP endpoint of the
+// store, so only objects that can be read without credentials (or through a credential-injecting proxy configured as
+// the s3 endpoint) are supported.
+func getJarDownloadURL(jarURI string) (string, error) {
+	u, err := url.Parse(jarURI)
+	if err != nil {
+		return "", err
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return jarURI, nil
+	case "s3":
+		endpoint := config.GetConfig().S3Endpoint
+		if endpoint == "" {
+			endpoint = "https://s3.amazonaws.com"
+		}
+		return strings.TrimSuffix(endpoint, "/") + "/" + u.Host + u.Path, nil
+	case "gs":
+		return gcsEndpoint + "/" + u.Host + u.Path, nil
+	}
+	return "", errors.Errorf("unsupported jar URI scheme %q", u.Scheme)
+}
+
+// Returns the file name the jar is uploaded to the cluster with
+func GetJarFileName(jarURI string) string {
+	u, err := url.Parse(jarURI)
+	if err != nil || u.Path == "" {
+		return "job.jar"
+	}
+	return path.Base(u.Path)
+}
+
+// Downloads the jar at the given URI, which needs to be allowed by the operator config. If a checksum is given, the jar
+// is rejected unless its SHA-256 digest matches.
+func FetchJar(ctx context.Context, jarURI string, checksum string) ([]byte, error) {
+	u, err := url.Parse(jarURI)
+	if err != nil {
+		return nil, err
+	}
+	if !isJarURIAllowed(u) {
+		return nil, errors.Errorf("jar URI %s is not allowed by the operator's jarUriAllowlist", jarURI)
+	}
+
+	downloadURL, err := getJarDownloadURL(jarURI)
+	if err != nil {
+		return nil, err
+	}
+
+	jar, err := downloadJar(ctx, downloadURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download jar %s", jarURI)
+	}
+
+	if checksum != "" {
+		digest := sha256.Sum256(jar)
+		if actual := hex.EncodeToString(digest[:]); !strings.EqualFold(actual, checksum) {
+			return nil, errors.Errorf("checksum mismatch for jar %s: expected %s, got %s", jarURI, checksum, actual)
+		}
+	}
+	return jar, nil
+}
+
+func downloadJar(ctx context.Context, downloadURL string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, jarDownloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := jarDownloadClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download failed with status %s", resp.Status)
+	}
+
+	// read one byte past the limit to tell a jar of exactly maxJarSize bytes from a larger one
+	jar, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxJarSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(jar) > maxJarSize {
+		return nil, fmt.Errorf("jar is larger than %d bytes", maxJarSize)
+	}
+	return jar, nil
+}
@@ -0,0 +1,107 @@
+package flink
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lyft/flinkk8soperator/pkg/controller/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var testJar = []byte("PK fake jar contents")
+
+func testJarChecksum() string {
+	digest := sha256.Sum256(testJar)
+	return hex.EncodeToString(digest[:])
+}
+
+func setJarURIAllowlist(t *testing.T, c *config.Config, allowlist ...string) {
+	c.JarURIAllowlist = allowlist
+	assert.Nil(t, config.ConfigSection.SetConfig(c))
+}
+
+func TestFetchJarHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/artifacts/job.jar":
+			_, _ = w.Write(testJar)
+		case "/artifacts/redirect.jar":
+			http.Redirect(w, r, "/artifacts/job.jar", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	_, err := FetchJar(context.Background(), server.URL+"/artifacts/job.jar", "")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "jarUriAllowlist")
+
+	setJarURIAllowlist(t, &config.Config{}, server.URL)
+	defer func() {
+		_ = config.ConfigSection.SetConfig(&config.Config{})
+	}()
+
+	jar, err := FetchJar(context.Background(), server.URL+"/artifacts/job.jar", testJarChecksum())
+	assert.Nil(t, err)
+	assert.Equal(t, testJar, jar)
+
+	_, err = FetchJar(context.Background(), server.URL+"/artifacts/job.jar", "0000")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+
+	_, err = FetchJar(context.Background(), server.URL+"/artifacts/missing.jar", "")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "404")
+
+	// redirects are not followed
+	_, err = FetchJar(context.Background(), server.URL+"/artifacts/redirect.jar", "")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "302")
+}
+
+func TestFetchJarS3(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bucket/jobs/job.jar", r.URL.Path)
+		_, _ = w.Write(testJar)
+	}))
+	defer server.Close()
+
+	setJarURIAllowlist(t, &config.Config{
+		S3Endpoint: server.URL,
+	}, "s3://bucket")
+	defer func() {
+		_ = config.ConfigSection.SetConfig(&config.Config{})
+	}()
+
+	jar, err := FetchJar(context.Background(), "s3://bucket/jobs/job.jar", "")
+	assert.Nil(t, err)
+	assert.Equal(t, testJar, jar)
+}
+
+func TestFetchJarNotAllowed(t *testing.T) {
+	setJarURIAllowlist(t, &config.Config{}, "s3://bucket", "https://artifacts.example.com")
+	defer func() {
+		_ = config.ConfigSection.SetConfig(&config.Config{})
+	}()
+
+	for _, jarURI := range []string{
+		"s3://other-bucket/job.jar",
+		"http://artifacts.example.com/job.jar",
+		"https://169.254.169.254/latest/meta-data",
+		"file:///etc/passwd",
+	} {
+		_, err := FetchJar(context.Background(), jarURI, "")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "jarUriAllowlist")
+	}
+}
+
+func TestGetJarFileName(t *testing.T) {
+	assert.Equal(t, "job.jar", GetJarFileName("s3://bucket/jobs/job.jar"))
+	assert.Equal(t, "job-1.0.jar", GetJarFileName("https://artifacts.example.com/job-1.0.jar?version=2"))
+}
@@ -14,7 +14,6 @@ import (
 	v1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
 	k8_err "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -27,6 +26,7 @@ const (
 	JobManagerVersionServiceName        = "%s-%s"
 	JobManagerContainerName             = "jobmanager"
 	JobManagerArg                       = "jobmanager"
+	JobManagerApplicationArg            = "standalone-job"
 	JobManagerReadinessPath             = "/overview"
 	JobManagerReadinessInitialDelaySec  = 10
 	JobManagerReadinessTimeoutSec       = 1
@@ -159,16 +159,7 @@ func (j *JobManagerController) CreateIfNotExist(ctx context.Context, application
 	return newlyCreated, nil
 }
 
-var JobManagerDefaultResources = coreV1.ResourceRequirements{
-	Requests: coreV1.ResourceList{
-		coreV1.ResourceCPU:    resource.MustParse("4"),
-		coreV1.ResourceMemory: resource.MustParse("3072Mi"),
-	},
-	Limits: coreV1.ResourceList{
-		coreV1.ResourceCPU:    resource.MustParse("4"),
-		coreV1.ResourceMemory: resource.MustParse("3072Mi"),
-	},
-}
+var JobManagerDefaultResources = v1beta1.DefaultJobManagerResources
 
 func getJobManagerPodName(application *v1beta1.FlinkApplication, hash string) string {
 	applicationName := application.Name
@@ -256,6 +247,53 @@ func getJobManagerPorts(app *v1beta1.FlinkApplication) []coreV1.ContainerPort {
 	}
 }
 
+// In application mode, the job manager is started with the job's entry class and arguments. The jar itself must be
+// on the user classpath of the image (e.g., /opt/flink/usrlib).
+func getJobManagerArgs(app *v1beta1.FlinkApplication) []string {
+	if !v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) {
+		return []string{JobManagerArg}
+	}
+
+	args := []string{JobManagerApplicationArg}
+	if app.Spec.EntryClass != "" {
+		args = append(args, "--job-classname", app.Spec.EntryClass)
+	}
+	return append(args, splitProgramArgs(app.Spec.ProgramArgs)...)
+}
+
+// The savepoint to restore from is only known once the previous job has been stopped, so it is added to the
+// arguments after the hash has been computed. The options must precede the program arguments.
+func injectApplicationModeSavepoint(deployment *v1.Deployment, app *v1beta1.FlinkApplication) {
+	savepointPath := GetSavepointPathForDeploy(app)
+	if !v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) || savepointPath == "" {
+		return
+	}
+
+	options := []string{"--fromSavepoint", savepointPath}
+	if app.Spec.AllowNonRestoredState {
+		options = append(options, "--allowNonRestoredState")
+	}
+
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	container.Args = append(append([]string{container.Args[0]}, options...), container.Args[1:]...)
+}
+
+// Points the job manager of an existing application mode cluster at another savepoint, which restarts it. Returns
+// false if it already starts from that savepoint.
+func setApplicationModeSavepoint(deployment *v1.Deployment, savepointPath string) bool {
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	if len(container.Args) > 2 && container.Args[1] == "--fromSavepoint" {
+		if container.Args[2] == savepointPath {
+			return false
+		}
+		container.Args[2] = savepointPath
+		return true
+	}
+
+	container.Args = append([]string{container.Args[0], "--fromSavepoint", savepointPath}, container.Args[1:]...)
+	return true
+}
+
 func FetchJobManagerContainerObj(application *v1beta1.FlinkApplication) *coreV1.Container {
 	jmConfig := application.Spec.JobManagerConfig
 	resources := jmConfig.Resources
@@ -276,11 +314,11 @@ func FetchJobManagerContainerObj(application *v1beta1.FlinkApplication) *coreV1.
 		Image:           application.Spec.Image,
 		ImagePullPolicy: ImagePullPolicy(application),
 		Resources:       *resources,
-		Args:            []string{JobManagerArg},
+		Args:            getJobManagerArgs(application),
 		Ports:           ports,
 		Env:             operatorEnv,
 		EnvFrom:         jmConfig.EnvConfig.EnvFrom,
-		VolumeMounts:    application.Spec.VolumeMounts,
+		VolumeMounts:    mergeVolumeMounts(application.Spec.VolumeMounts, jmConfig.VolumeMounts),
 		ReadinessProbe: &coreV1.Probe{
 			Handler: coreV1.Handler{
 				HTTPGet: &coreV1.HTTPGetAction{
@@ -340,13 +378,13 @@ func jobmanagerTemplate(app *v1beta1.FlinkApplication) *v1.Deployment {
 				ObjectMeta: metaV1.ObjectMeta{
 					Namespace:   app.Namespace,
 					Labels:      labels,
-					Annotations: app.Annotations,
+					Annotations: getPodAnnotations(app),
 				},
 				Spec: coreV1.PodSpec{
-					Containers: []coreV1.Container{
-						*jobManagerContainer,
-					},
-					Volumes:          app.Spec.Volumes,
+					Containers: append([]coreV1.Container{*jobManagerContainer},
+						withVolumeMounts(jobManagerContainer.VolumeMounts, app.Spec.JobManagerConfig.Sidecars)...),
+					InitContainers:   withVolumeMounts(jobManagerContainer.VolumeMounts, app.Spec.JobManagerConfig.InitContainers),
+					Volumes:          mergeVolumes(app.Spec.Volumes, app.Spec.JobManagerConfig.Volumes),
 					ImagePullSecrets: app.Spec.ImagePullSecrets,
 					NodeSelector:     app.Spec.JobManagerConfig.NodeSelector,
 					Tolerations:      app.Spec.JobManagerConfig.Tolerations,
@@ -364,6 +402,9 @@ func jobmanagerTemplate(app *v1beta1.FlinkApplication) *v1.Deployment {
 		deployment.Spec.Template.Spec.SecurityContext = app.Spec.SecurityContext
 	}
 
+	applyPodTemplate(&deployment.Spec.Template, app.Spec.JobManagerConfig.PodTemplate,
+		getFlinkContainerName(JobManagerContainerName))
+
 	return deployment
 }
 
@@ -377,6 +418,7 @@ func FetchJobMangerDeploymentCreateObj(app *v1beta1.FlinkApplication, hash strin
 	template.Spec.Template.Name = getJobManagerPodName(app, hash)
 
 	InjectOperatorCustomizedConfig(template, app, hash, FlinkDeploymentTypeJobmanager)
+	injectApplicationModeSavepoint(template, app)
 
 	return template
 }
@@ -386,3 +386,35 @@ func TestJobManagerCreateSuccessWithVersion(t *testing.T) {
 	assert.True(t, newlyCreated)
 	assert.Equal(t, 4, ctr)
 }
+
+func TestJobManagerApplicationModeArgs(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.ExecutionMode = v1beta12.ExecutionModeApplication
+	app.Spec.EntryClass = testEntryClass
+	app.Spec.ProgramArgs = "--input 'a b' --output \"c d\""
+
+	deployment := FetchJobMangerDeploymentCreateObj(&app, testAppHash)
+	assert.Equal(t, []string{"standalone-job", "--job-classname", testEntryClass,
+		"--input", "a b", "--output", "c d"}, deployment.Spec.Template.Spec.Containers[0].Args)
+	assert.Contains(t, common.GetEnvVar(deployment.Spec.Template.Spec.Containers[0].Env,
+		"FLINK_PROPERTIES").Value, "parallelism.default: 8\n")
+
+	// once the previous job has been stopped, the new job is started from its savepoint
+	app.Status.DeployHash = "old-hash"
+	app.Status.SavepointPath = "s3://savepoints/1"
+	app.Spec.AllowNonRestoredState = true
+	deployment = FetchJobMangerDeploymentCreateObj(&app, testAppHash)
+	assert.Equal(t, []string{"standalone-job", "--fromSavepoint", "s3://savepoints/1", "--allowNonRestoredState",
+		"--job-classname", testEntryClass, "--input", "a b", "--output", "c d"},
+		deployment.Spec.Template.Spec.Containers[0].Args)
+}
+
+func TestJobManagerSessionModeArgs(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.EntryClass = testEntryClass
+	app.Status.DeployHash = "old-hash"
+	app.Status.SavepointPath = "s3://savepoints/1"
+
+	deployment := FetchJobMangerDeploymentCreateObj(&app, testAppHash)
+	assert.Equal(t, []string{"jobmanager"}, deployment.Spec.Template.Spec.Containers[0].Args)
+}
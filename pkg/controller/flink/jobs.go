@@ -0,0 +1,53 @@
+package flink
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+// Returns a hash of the parts of a job in spec.jobs that the job is submitted with. The savepoint is left out, as it
+// is only used when the job is first submitted, so changing it does not restart the job.
+func HashForSessionJob(job *v1beta1.SessionJob) string {
+	normalized := *job
+	normalized.SavepointPath = ""
+	jsonObj, err := json.Marshal(normalized)
+	if err != nil {
+		// the job only consists of strings and numbers, which can always be marshalled
+		panic(fmt.Sprintf("got error trying when marshalling job %v", err))
+	}
+
+	hasher := fnv.New32a()
+	_, err = hasher.Write(jsonObj)
+	if err != nil {
+		// the hasher cannot actually throw an error on write
+		panic(fmt.Sprintf("got error trying when writing to hasher %v", err))
+	}
+	return fmt.Sprintf("%08x", hasher.Sum32())
+}
+
+// Returns the status of the job in spec.jobs with the given name, or nil if it has not been submitted yet
+func GetSessionJobStatus(app *v1beta1.FlinkApplication, name string) *v1beta1.SessionJobStatus {
+	for i := range app.Status.Jobs {
+		if app.Status.Jobs[i].Name == name {
+			return &app.Status.Jobs[i]
+		}
+	}
+	return nil
+}
+
+func getSessionJob(app *v1beta1.FlinkApplication, name string) *v1beta1.SessionJob {
+	for i := range app.Spec.Jobs {
+		if app.Spec.Jobs[i].Name == name {
+			return &app.Spec.Jobs[i]
+		}
+	}
+	return nil
+}
+
+// Returns whether the job with the given name has been removed from spec.jobs
+func IsSessionJobRemoved(app *v1beta1.FlinkApplication, name string) bool {
+	return getSessionJob(app, name) == nil
+}
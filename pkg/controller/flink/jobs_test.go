@@ -0,0 +1,36 @@
+package flink
+
+import (
+	"testing"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashForSessionJob(t *testing.T) {
+	job := v1beta1.SessionJob{
+		Name:        "enrichment",
+		JarName:     "enrichment.jar",
+		Parallelism: 2,
+	}
+	hash := HashForSessionJob(&job)
+	assert.Equal(t, 8, len(hash))
+
+	// the savepoint is only used on the first submission
+	job.SavepointPath = "s3://savepoints/enrichment"
+	assert.Equal(t, hash, HashForSessionJob(&job))
+
+	job.Parallelism = 4
+	assert.NotEqual(t, hash, HashForSessionJob(&job))
+}
+
+func TestGetSessionJobStatus(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.Jobs = []v1beta1.SessionJob{{Name: "enrichment"}}
+	app.Status.Jobs = []v1beta1.SessionJobStatus{{Name: "enrichment"}, {Name: "removed"}}
+
+	assert.Equal(t, &app.Status.Jobs[0], GetSessionJobStatus(&app, "enrichment"))
+	assert.Nil(t, GetSessionJobStatus(&app, "new"))
+	assert.False(t, IsSessionJobRemoved(&app, "enrichment"))
+	assert.True(t, IsSessionJobRemoved(&app, "removed"))
+}
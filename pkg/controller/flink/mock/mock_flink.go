@@ -13,9 +13,12 @@ type CreateClusterFunc func(ctx context.Context, application *v1beta1.FlinkAppli
 type DeleteOldResourcesForApp func(ctx context.Context, application *v1beta1.FlinkApplication) error
 type SavepointFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error)
 type ForceCancelFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) error
+type UploadJarFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jarURI string,
+	checksum string) (string, error)
 type StartFlinkJobFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
 	jarName string, parallelism int32, entryClass string, programArgs string, allowNonRestoredState bool, savepointPath string) (string, error)
 type GetSavepointStatusFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) (*client.SavepointResponse, error)
+type GetSavepointStatusForTriggerFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error)
 type IsClusterReadyFunc func(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error)
 type IsServiceReadyFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (bool, error)
 type GetJobsForApplicationFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) ([]client.FlinkJob, error)
@@ -31,39 +34,49 @@ type UpdateLatestJobIDFunc func(ctx context.Context, app *v1beta1.FlinkApplicati
 type UpdateLatestJobStatusFunc func(ctx context.Context, app *v1beta1.FlinkApplication, jobStatus v1beta1.FlinkJobStatus)
 type UpdateLatestClusterStatusFunc func(ctx context.Context, app *v1beta1.FlinkApplication, clusterStatus v1beta1.FlinkClusterStatus)
 type UpdateLatestVersionAndHashFunc func(application *v1beta1.FlinkApplication, version v1beta1.FlinkApplicationVersion, hash string)
+type UpdatePodDisruptionBudgetFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (bool, error)
+type RestartJobManagerFromSavepointFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, savepointPath string) (bool, error)
+type DeleteHAConfigMapsForAppFunc func(ctx context.Context, application *v1beta1.FlinkApplication) error
 type DeleteResourcesForAppWithHashFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) error
 type DeleteStatusPostTeardownFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string)
 type GetJobToDeleteForApplicationFunc func(ctx context.Context, app *v1beta1.FlinkApplication, hash string) (*client.FlinkJobOverview, error)
 type GetVersionAndJobIDForHashFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (string, string, error)
 type GetVersionAndHashPostTeardownFunc func(ctx context.Context, application *v1beta1.FlinkApplication) (v1beta1.FlinkApplicationVersion, string)
+type GetAutoscalerUtilizationFunc func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (v1beta1.AutoscalerMetric, float64, error)
 type FlinkController struct {
-	CreateClusterFunc                 CreateClusterFunc
-	DeleteOldResourcesForAppFunc      DeleteOldResourcesForApp
-	SavepointFunc                     SavepointFunc
-	ForceCancelFunc                   ForceCancelFunc
-	StartFlinkJobFunc                 StartFlinkJobFunc
-	GetSavepointStatusFunc            GetSavepointStatusFunc
-	IsClusterReadyFunc                IsClusterReadyFunc
-	IsServiceReadyFunc                IsServiceReadyFunc
-	GetJobsForApplicationFunc         GetJobsForApplicationFunc
-	GetJobForApplicationFunc          GetJobForApplicationFunc
-	GetCurrentDeploymentsForAppFunc   GetCurrentDeploymentsForAppFunc
-	FindExternalizedCheckpointFunc    FindExternalizedCheckpointFunc
-	Events                            []corev1.Event
-	CompareAndUpdateClusterStatusFunc CompareAndUpdateClusterStatusFunc
-	CompareAndUpdateJobStatusFunc     CompareAndUpdateJobStatusFunc
-	GetLatestClusterStatusFunc        GetLatestClusterStatusFunc
-	GetLatestJobStatusFunc            GetLatestJobStatusFunc
-	GetLatestJobIDFunc                GetLatestJobIDFunc
-	UpdateLatestJobIDFunc             UpdateLatestJobIDFunc
-	UpdateLatestJobStatusFunc         UpdateLatestJobStatusFunc
-	UpdateLatestClusterStatusFunc     UpdateLatestClusterStatusFunc
-	UpdateLatestVersionAndHashFunc    UpdateLatestVersionAndHashFunc
-	DeleteResourcesForAppWithHashFunc DeleteResourcesForAppWithHashFunc
-	DeleteStatusPostTeardownFunc      DeleteStatusPostTeardownFunc
-	GetJobToDeleteForApplicationFunc  GetJobToDeleteForApplicationFunc
-	GetVersionAndJobIDForHashFunc     GetVersionAndJobIDForHashFunc
-	GetVersionAndHashPostTeardownFunc GetVersionAndHashPostTeardownFunc
+	CreateClusterFunc                  CreateClusterFunc
+	DeleteOldResourcesForAppFunc       DeleteOldResourcesForApp
+	SavepointFunc                      SavepointFunc
+	ForceCancelFunc                    ForceCancelFunc
+	UploadJarFunc                      UploadJarFunc
+	StartFlinkJobFunc                  StartFlinkJobFunc
+	GetSavepointStatusFunc             GetSavepointStatusFunc
+	GetSavepointStatusForTriggerFunc   GetSavepointStatusForTriggerFunc
+	IsClusterReadyFunc                 IsClusterReadyFunc
+	IsServiceReadyFunc                 IsServiceReadyFunc
+	GetJobsForApplicationFunc          GetJobsForApplicationFunc
+	GetJobForApplicationFunc           GetJobForApplicationFunc
+	GetCurrentDeploymentsForAppFunc    GetCurrentDeploymentsForAppFunc
+	FindExternalizedCheckpointFunc     FindExternalizedCheckpointFunc
+	Events                             []corev1.Event
+	CompareAndUpdateClusterStatusFunc  CompareAndUpdateClusterStatusFunc
+	CompareAndUpdateJobStatusFunc      CompareAndUpdateJobStatusFunc
+	GetLatestClusterStatusFunc         GetLatestClusterStatusFunc
+	GetLatestJobStatusFunc             GetLatestJobStatusFunc
+	GetLatestJobIDFunc                 GetLatestJobIDFunc
+	UpdateLatestJobIDFunc              UpdateLatestJobIDFunc
+	UpdateLatestJobStatusFunc          UpdateLatestJobStatusFunc
+	UpdateLatestClusterStatusFunc      UpdateLatestClusterStatusFunc
+	UpdateLatestVersionAndHashFunc     UpdateLatestVersionAndHashFunc
+	DeleteResourcesForAppWithHashFunc  DeleteResourcesForAppWithHashFunc
+	DeleteHAConfigMapsForAppFunc       DeleteHAConfigMapsForAppFunc
+	UpdatePodDisruptionBudgetFunc      UpdatePodDisruptionBudgetFunc
+	RestartJobManagerFromSavepointFunc RestartJobManagerFromSavepointFunc
+	DeleteStatusPostTeardownFunc       DeleteStatusPostTeardownFunc
+	GetJobToDeleteForApplicationFunc   GetJobToDeleteForApplicationFunc
+	GetVersionAndJobIDForHashFunc      GetVersionAndJobIDForHashFunc
+	GetVersionAndHashPostTeardownFunc  GetVersionAndHashPostTeardownFunc
+	GetAutoscalerUtilizationFunc       GetAutoscalerUtilizationFunc
 }
 
 func (m *FlinkController) GetCurrentDeploymentsForApp(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
@@ -101,6 +114,14 @@ func (m *FlinkController) ForceCancel(ctx context.Context, application *v1beta1.
 	return nil
 }
 
+func (m *FlinkController) UploadJar(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+	jarURI string, checksum string) (string, error) {
+	if m.UploadJarFunc != nil {
+		return m.UploadJarFunc(ctx, application, hash, jarURI, checksum)
+	}
+	return "", nil
+}
+
 func (m *FlinkController) StartFlinkJob(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
 	jarName string, parallelism int32, entryClass string, programArgs string, allowNonRestoredState bool, savepointPath string) (string, error) {
 	if m.StartFlinkJobFunc != nil {
@@ -116,6 +137,13 @@ func (m *FlinkController) GetSavepointStatus(ctx context.Context, application *v
 	return nil, nil
 }
 
+func (m *FlinkController) GetSavepointStatusForTrigger(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error) {
+	if m.GetSavepointStatusForTriggerFunc != nil {
+		return m.GetSavepointStatusForTriggerFunc(ctx, application, hash, jobID, triggerID)
+	}
+	return nil, nil
+}
+
 func (m *FlinkController) IsClusterReady(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
 	if m.IsClusterReadyFunc != nil {
 		return m.IsClusterReadyFunc(ctx, application)
@@ -261,6 +289,27 @@ func (m *FlinkController) DeleteResourcesForAppWithHash(ctx context.Context, app
 	return nil
 }
 
+func (m *FlinkController) UpdatePodDisruptionBudget(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (bool, error) {
+	if m.UpdatePodDisruptionBudgetFunc != nil {
+		return m.UpdatePodDisruptionBudgetFunc(ctx, application, hash)
+	}
+	return false, nil
+}
+
+func (m *FlinkController) RestartJobManagerFromSavepoint(ctx context.Context, application *v1beta1.FlinkApplication, hash string, savepointPath string) (bool, error) {
+	if m.RestartJobManagerFromSavepointFunc != nil {
+		return m.RestartJobManagerFromSavepointFunc(ctx, application, hash, savepointPath)
+	}
+	return false, nil
+}
+
+func (m *FlinkController) DeleteHAConfigMapsForApp(ctx context.Context, application *v1beta1.FlinkApplication) error {
+	if m.DeleteHAConfigMapsForAppFunc != nil {
+		return m.DeleteHAConfigMapsForAppFunc(ctx, application)
+	}
+	return nil
+}
+
 func (m *FlinkController) DeleteStatusPostTeardown(ctx context.Context, application *v1beta1.FlinkApplication, hash string) {
 	if m.DeleteStatusPostTeardownFunc != nil {
 		m.DeleteStatusPostTeardownFunc(ctx, application, hash)
@@ -290,9 +339,17 @@ func (m *FlinkController) GetVersionAndHashPostTeardown(ctx context.Context, app
 	return application.Status.VersionStatuses[0].Version, application.Status.VersionStatuses[0].VersionHash
 }
 
+func (m *FlinkController) GetAutoscalerUtilization(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (v1beta1.AutoscalerMetric, float64, error) {
+	if m.GetAutoscalerUtilizationFunc != nil {
+		return m.GetAutoscalerUtilizationFunc(ctx, application, hash)
+	}
+	return "", 0, nil
+}
+
 func getCurrentStatusIndex(app *v1beta1.FlinkApplication) int32 {
 	desiredCount := v1beta1.GetMaxRunningJobs(app.Spec.DeploymentMode)
-	if v1beta1.IsRunningPhase(app.Status.Phase) {
+	if v1beta1.IsRunningPhase(app.Status.Phase) || app.Status.Phase == v1beta1.FlinkApplicationSuspending ||
+		v1beta1.IsFinishedPhase(app.Status.Phase) {
 		return 0
 	}
 
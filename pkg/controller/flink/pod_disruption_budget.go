@@ -0,0 +1,105 @@
+package flink
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/k8"
+	policyV1beta1 "k8s.io/api/policy/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	PodDisruptionBudgetNameFormat           = "%s-%s-pdb"
+	JobManagerPodDisruptionBudgetNameFormat = "%s-%s-jm-pdb"
+	// the share of the task managers that may be evicted at once, unless configured otherwise
+	defaultMaxUnavailableFraction = 0.1
+)
+
+func getPodDisruptionBudgetName(application *v1beta1.FlinkApplication, hash string) string {
+	return fmt.Sprintf(PodDisruptionBudgetNameFormat, application.Name, hash)
+}
+
+func getJobManagerPodDisruptionBudgetName(application *v1beta1.FlinkApplication, hash string) string {
+	return fmt.Sprintf(JobManagerPodDisruptionBudgetNameFormat, application.Name, hash)
+}
+
+// Every task manager that is evicted restarts the job, so by default only a tenth of them (rounded up) may be evicted
+// at the same time. This lets node drains make progress without taking away many of the cluster's slots at once. At
+// least one may always be evicted, as a budget that allows none blocks drains entirely.
+func getMaxUnavailable(app *v1beta1.FlinkApplication) intstr.IntOrString {
+	if app.Spec.PodDisruptionBudget != nil && app.Spec.PodDisruptionBudget.MaxUnavailable != nil {
+		return *app.Spec.PodDisruptionBudget.MaxUnavailable
+	}
+	replicas := computeTaskManagerReplicas(app)
+	return intstr.FromInt(int(math.Max(1, math.Ceil(float64(replicas)*defaultMaxUnavailableFraction))))
+}
+
+// Translates a FlinkApplication into the PodDisruptionBudget for the task manager pods of the cluster with the given
+// hash
+func FetchPodDisruptionBudgetCreateObj(app *v1beta1.FlinkApplication, hash string) *policyV1beta1.PodDisruptionBudget {
+	return podDisruptionBudgetTemplate(app, hash, getPodDisruptionBudgetName(app, hash),
+		FlinkDeploymentTypeTaskmanager, getMaxUnavailable(app))
+}
+
+// Translates a FlinkApplication into the PodDisruptionBudget for the job manager pods of the cluster with the given
+// hash. Without high availability there is a single job manager, whose eviction cannot be avoided without blocking
+// drains, so the budget only exists when there are standby job managers. Only one of them may be evicted at a time,
+// so that a leader is always available. Returns nil if the cluster has no budget for its job managers.
+func FetchJobManagerPodDisruptionBudgetCreateObj(app *v1beta1.FlinkApplication,
+	hash string) *policyV1beta1.PodDisruptionBudget {
+	if app.Spec.HighAvailability == nil || getJobmanagerReplicas(app) <= 1 {
+		return nil
+	}
+	return podDisruptionBudgetTemplate(app, hash, getJobManagerPodDisruptionBudgetName(app, hash),
+		FlinkDeploymentTypeJobmanager, intstr.FromInt(1))
+}
+
+// Returns the budgets for the pods of the cluster with the given hash
+func FetchPodDisruptionBudgetsCreateObj(app *v1beta1.FlinkApplication, hash string) []*policyV1beta1.PodDisruptionBudget {
+	budgets := []*policyV1beta1.PodDisruptionBudget{FetchPodDisruptionBudgetCreateObj(app, hash)}
+	if budget := FetchJobManagerPodDisruptionBudgetCreateObj(app, hash); budget != nil {
+		budgets = append(budgets, budget)
+	}
+	return budgets
+}
+
+func podDisruptionBudgetTemplate(app *v1beta1.FlinkApplication, hash string, name string, deploymentType string,
+	maxUnavailable intstr.IntOrString) *policyV1beta1.PodDisruptionBudget {
+	labels := getCommonAppLabels(app)
+	labels[FlinkAppHash] = hash
+
+	selector := k8.GetAppLabel(app.Name)
+	selector[FlinkAppHash] = hash
+	selector[FlinkDeploymentType] = deploymentType
+
+	return &policyV1beta1.PodDisruptionBudget{
+		TypeMeta: metaV1.TypeMeta{
+			APIVersion: policyV1beta1.SchemeGroupVersion.String(),
+			Kind:       k8.PodDisruptionBudget,
+		},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: app.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metaV1.OwnerReference{
+				*metaV1.NewControllerRef(app, app.GroupVersionKind()),
+			},
+		},
+		Spec: policyV1beta1.PodDisruptionBudgetSpec{
+			Selector: &metaV1.LabelSelector{
+				MatchLabels: selector,
+			},
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+}
+
+// Returns whether the budget is one of those of the cluster with the given hash
+func PodDisruptionBudgetMatches(budget *policyV1beta1.PodDisruptionBudget, application *v1beta1.FlinkApplication,
+	hash string) bool {
+	return budget.Name == getPodDisruptionBudgetName(application, hash) ||
+		budget.Name == getJobManagerPodDisruptionBudgetName(application, hash)
+}
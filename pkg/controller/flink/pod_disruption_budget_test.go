@@ -0,0 +1,85 @@
+package flink
+
+import (
+	"testing"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPodDisruptionBudgetCreateObj(t *testing.T) {
+	app := getFlinkTestApp()
+
+	budget := FetchPodDisruptionBudgetCreateObj(&app, testAppHash)
+	assert.Equal(t, testAppName+"-"+testAppHash+"-pdb", budget.Name)
+	assert.Equal(t, testNamespace, budget.Namespace)
+	assert.Equal(t, map[string]string{
+		"flink-app":             testAppName,
+		"flink-app-hash":        testAppHash,
+		"flink-deployment-type": "taskmanager",
+	}, budget.Spec.Selector.MatchLabels)
+	assert.Equal(t, map[string]string{
+		"flink-app":      testAppName,
+		"flink-app-hash": testAppHash,
+	}, budget.Labels)
+	assert.Equal(t, v1beta1.FlinkApplicationKind, budget.OwnerReferences[0].Kind)
+	assert.True(t, PodDisruptionBudgetMatches(budget, &app, testAppHash))
+
+	// the selector matches the pods of the task managers, but not the job manager
+	selector := labels.SelectorFromSet(budget.Spec.Selector.MatchLabels)
+	assert.True(t, selector.Matches(labels.Set(FetchTaskMangerDeploymentCreateObj(&app, testAppHash).Spec.Template.Labels)))
+	assert.False(t, selector.Matches(labels.Set(FetchJobMangerDeploymentCreateObj(&app, testAppHash).Spec.Template.Labels)))
+}
+
+func TestPodDisruptionBudgetMaxUnavailable(t *testing.T) {
+	app := getFlinkTestApp()
+	taskSlots := int32(1)
+	app.Spec.TaskManagerConfig.TaskSlots = &taskSlots
+
+	app.Spec.Parallelism = 4
+	assert.Equal(t, intstr.FromInt(1), *FetchPodDisruptionBudgetCreateObj(&app, testAppHash).Spec.MaxUnavailable)
+
+	// a budget without any task managers would otherwise block drains
+	app.Spec.Parallelism = 0
+	assert.Equal(t, intstr.FromInt(1), *FetchPodDisruptionBudgetCreateObj(&app, testAppHash).Spec.MaxUnavailable)
+
+	app.Spec.Parallelism = 25
+	assert.Equal(t, intstr.FromInt(3), *FetchPodDisruptionBudgetCreateObj(&app, testAppHash).Spec.MaxUnavailable)
+
+	maxUnavailable := intstr.FromString("50%")
+	app.Spec.PodDisruptionBudget = &v1beta1.DisruptionBudgetConfig{MaxUnavailable: &maxUnavailable}
+	assert.Equal(t, maxUnavailable, *FetchPodDisruptionBudgetCreateObj(&app, testAppHash).Spec.MaxUnavailable)
+}
+
+func TestJobManagerPodDisruptionBudgetCreateObj(t *testing.T) {
+	app := getFlinkTestApp()
+	replicas := int32(2)
+	app.Spec.JobManagerConfig.Replicas = &replicas
+
+	// without high availability there are no standby job managers
+	assert.Nil(t, FetchJobManagerPodDisruptionBudgetCreateObj(&app, testAppHash))
+	assert.Equal(t, 1, len(FetchPodDisruptionBudgetsCreateObj(&app, testAppHash)))
+
+	app.Spec.HighAvailability = &v1beta1.HighAvailabilityConfig{StorageDir: "s3://flink/ha"}
+	budget := FetchJobManagerPodDisruptionBudgetCreateObj(&app, testAppHash)
+	assert.Equal(t, testAppName+"-"+testAppHash+"-jm-pdb", budget.Name)
+	assert.Equal(t, intstr.FromInt(1), *budget.Spec.MaxUnavailable)
+	assert.Equal(t, map[string]string{
+		"flink-app":             testAppName,
+		"flink-app-hash":        testAppHash,
+		"flink-deployment-type": "jobmanager",
+	}, budget.Spec.Selector.MatchLabels)
+	assert.True(t, PodDisruptionBudgetMatches(budget, &app, testAppHash))
+	assert.False(t, PodDisruptionBudgetMatches(budget, &app, "oldhash"))
+	assert.Equal(t, 2, len(FetchPodDisruptionBudgetsCreateObj(&app, testAppHash)))
+
+	selector := labels.SelectorFromSet(budget.Spec.Selector.MatchLabels)
+	assert.True(t, selector.Matches(labels.Set(FetchJobMangerDeploymentCreateObj(&app, testAppHash).Spec.Template.Labels)))
+	assert.False(t, selector.Matches(labels.Set(FetchTaskMangerDeploymentCreateObj(&app, testAppHash).Spec.Template.Labels)))
+
+	// a single job manager cannot be protected without blocking drains
+	replicas = 1
+	assert.Nil(t, FetchJobManagerPodDisruptionBudgetCreateObj(&app, testAppHash))
+}
@@ -0,0 +1,124 @@
+package flink
+
+import (
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// Typed objects marshal their empty fields without omitempty (e.g., containers) as null, which in a strategic merge
+// patch would delete the field. As a typed podTemplate cannot express deletions, these are dropped from the patch.
+func removeNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if item == nil {
+				delete(v, key)
+			} else {
+				v[key] = removeNulls(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = removeNulls(item)
+		}
+	}
+	return v
+}
+
+func getPodTemplatePatch(podTemplate *coreV1.PodTemplateSpec) ([]byte, error) {
+	raw, err := json.Marshal(podTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var patch map[string]interface{}
+	if err := json.Unmarshal(raw, &patch); err != nil {
+		return nil, err
+	}
+	return json.Marshal(removeNulls(patch))
+}
+
+// The env of the Flink container starts with the operator's variables, followed by any others from the podTemplate
+func mergeFlinkContainerEnv(operatorEnv []coreV1.EnvVar, mergedEnv []coreV1.EnvVar) []coreV1.EnvVar {
+	env := append([]coreV1.EnvVar{}, operatorEnv...)
+	operatorNames := make(map[string]bool, len(operatorEnv))
+	for _, e := range operatorEnv {
+		operatorNames[e.Name] = true
+	}
+	for _, e := range mergedEnv {
+		if !operatorNames[e.Name] {
+			env = append(env, e)
+		}
+	}
+	return env
+}
+
+// Strategically merges the podTemplate from the job manager or task manager config into the pod template generated
+// by the operator: containers, init containers, volumes and env vars are merged by name. The operator's labels, and
+// the image, args, ports, resources and env vars of the Flink container, take precedence over the podTemplate. The
+// Flink container is kept as the first container of the pod.
+func mergePodTemplate(template *coreV1.PodTemplateSpec, podTemplate *coreV1.PodTemplateSpec,
+	flinkContainerName string) (*coreV1.PodTemplateSpec, error) {
+	if podTemplate == nil {
+		return template, nil
+	}
+
+	original, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := getPodTemplatePatch(podTemplate)
+	if err != nil {
+		return nil, err
+	}
+	mergedJSON, err := strategicpatch.StrategicMergePatch(original, patch, coreV1.PodTemplateSpec{})
+	if err != nil {
+		return nil, err
+	}
+	merged := &coreV1.PodTemplateSpec{}
+	if err := json.Unmarshal(mergedJSON, merged); err != nil {
+		return nil, err
+	}
+
+	if len(template.Labels) > 0 && merged.Labels == nil {
+		merged.Labels = map[string]string{}
+	}
+	for key, value := range template.Labels {
+		merged.Labels[key] = value
+	}
+
+	var flinkContainer *coreV1.Container
+	for i := range template.Spec.Containers {
+		if template.Spec.Containers[i].Name == flinkContainerName {
+			flinkContainer = &template.Spec.Containers[i]
+		}
+	}
+	if flinkContainer == nil {
+		return merged, nil
+	}
+
+	containers := make([]coreV1.Container, 1, len(merged.Spec.Containers))
+	for _, container := range merged.Spec.Containers {
+		if container.Name != flinkContainerName {
+			containers = append(containers, container)
+			continue
+		}
+		container.Image = flinkContainer.Image
+		container.Args = flinkContainer.Args
+		container.Ports = flinkContainer.Ports
+		container.Resources = flinkContainer.Resources
+		container.Env = mergeFlinkContainerEnv(flinkContainer.Env, container.Env)
+		containers[0] = container
+	}
+	merged.Spec.Containers = containers
+
+	return merged, nil
+}
+
+// Applies the podTemplate to a generated deployment. Pod templates that cannot be merged are rejected by
+// validation, in which case the generated pod template is left as is.
+func applyPodTemplate(template *coreV1.PodTemplateSpec, podTemplate *coreV1.PodTemplateSpec, flinkContainerName string) {
+	if merged, err := mergePodTemplate(template, podTemplate, flinkContainerName); err == nil {
+		*template = *merged
+	}
+}
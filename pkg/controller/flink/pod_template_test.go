@@ -0,0 +1,80 @@
+package flink
+
+import (
+	"testing"
+
+	"github.com/lyft/flinkk8soperator/pkg/controller/common"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+)
+
+func TestTaskManagerPodTemplate(t *testing.T) {
+	app := getFlinkTestApp()
+	flinkContainerName := getFlinkContainerName(TaskManagerContainerName)
+	app.Spec.TaskManagerConfig.PodTemplate = &coreV1.PodTemplateSpec{}
+	app.Spec.TaskManagerConfig.PodTemplate.Labels = map[string]string{
+		"team":                  "streaming",
+		"flink-deployment-type": "jobmanager",
+	}
+	app.Spec.TaskManagerConfig.PodTemplate.Spec = coreV1.PodSpec{
+		PriorityClassName: "high-priority",
+		Containers: []coreV1.Container{
+			{
+				Name:  flinkContainerName,
+				Image: "another-image",
+				Env: []coreV1.EnvVar{
+					{Name: "EXTRA", Value: "value"},
+					{Name: FlinkDeploymentTypeEnv, Value: FlinkDeploymentTypeJobmanager},
+				},
+				Lifecycle: &coreV1.Lifecycle{
+					PreStop: &coreV1.Handler{
+						Exec: &coreV1.ExecAction{Command: []string{"sleep", "10"}},
+					},
+				},
+			},
+			{
+				Name:  "sidecar",
+				Image: "sidecar-image",
+			},
+		},
+	}
+
+	deployment := FetchTaskMangerDeploymentCreateObj(&app, testAppHash)
+	podTemplate := deployment.Spec.Template
+
+	assert.Equal(t, "high-priority", podTemplate.Spec.PriorityClassName)
+	assert.Equal(t, "streaming", podTemplate.Labels["team"])
+	assert.Equal(t, FlinkDeploymentTypeTaskmanager, podTemplate.Labels[FlinkDeploymentType])
+	assert.Equal(t, testAppHash, podTemplate.Labels[FlinkAppHash])
+	assert.Equal(t, deployment.Spec.Selector.MatchLabels[FlinkDeploymentType], podTemplate.Labels[FlinkDeploymentType])
+
+	assert.Equal(t, 2, len(podTemplate.Spec.Containers))
+	flinkContainer := podTemplate.Spec.Containers[0]
+	assert.Equal(t, flinkContainerName, flinkContainer.Name)
+	assert.Equal(t, testImage, flinkContainer.Image)
+	assert.Equal(t, []string{"sleep", "10"}, flinkContainer.Lifecycle.PreStop.Exec.Command)
+	assert.Equal(t, TaskManagerDefaultResources, flinkContainer.Resources)
+	assert.Equal(t, FlinkDeploymentTypeTaskmanager, common.GetEnvVar(flinkContainer.Env, FlinkDeploymentTypeEnv).Value)
+	assert.Equal(t, "value", common.GetEnvVar(flinkContainer.Env, "EXTRA").Value)
+	assert.NotNil(t, common.GetEnvVar(flinkContainer.Env, OperatorFlinkConfig))
+	assert.Equal(t, "sidecar-image", podTemplate.Spec.Containers[1].Image)
+}
+
+func TestHashForPodTemplate(t *testing.T) {
+	app := getFlinkTestApp()
+	h1 := HashForApplication(&app)
+
+	app.Spec.JobManagerConfig.PodTemplate = &coreV1.PodTemplateSpec{}
+	assert.Equal(t, h1, HashForApplication(&app))
+
+	app.Spec.JobManagerConfig.PodTemplate.Spec.PriorityClassName = "high-priority"
+	h2 := HashForApplication(&app)
+	assert.NotEqual(t, h1, h2)
+
+	app.Spec.TaskManagerConfig.PodTemplate = &coreV1.PodTemplateSpec{
+		Spec: coreV1.PodSpec{
+			InitContainers: []coreV1.Container{{Name: "init", Image: "init-image"}},
+		},
+	}
+	assert.NotEqual(t, h2, HashForApplication(&app))
+}
@@ -0,0 +1,73 @@
+package flink
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+)
+
+// Returns the policy that new versions of the application are promoted by, or nil if the old version is only torn
+// down once spec.tearDownVersionHash is set
+func GetPromotionPolicy(app *v1beta1.FlinkApplication) *v1beta1.PromotionPolicy {
+	if app.Spec.BlueGreen == nil || !v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
+		return nil
+	}
+	return app.Spec.BlueGreen.PromotionPolicy
+}
+
+func GetPromotionSoakDuration(policy *v1beta1.PromotionPolicy) time.Duration {
+	if policy.SoakDuration == nil {
+		return v1beta1.DefaultPromotionSoakDuration
+	}
+	return policy.SoakDuration.Duration
+}
+
+func int32OrDefault(value *int32, defaultValue int32) int32 {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
+
+// Evaluates the job of the new version against the promotion policy, based on the status of the evaluation so far.
+// Jobs that stop, restart or fail checkpoints too often are rolled back right away, while the decision to promote is
+// only made once the soak window has passed. Returns PromotionPending until then, and the reason for the decision
+// otherwise.
+func EvaluatePromotion(policy *v1beta1.PromotionPolicy, status *v1beta1.PromotionStatus, job v1beta1.FlinkJobStatus,
+	now time.Time) (v1beta1.PromotionDecision, string) {
+	switch job.State {
+	case v1beta1.Failed, v1beta1.Canceled, v1beta1.Finished:
+		return v1beta1.PromotionRolledBack, fmt.Sprintf("the job has stopped in state %s", job.State)
+	}
+
+	maxRestarts := int32OrDefault(policy.MaxJobRestarts, v1beta1.DefaultPromotionMaxJobRestarts)
+	if restarts := job.JobRestartCount - status.InitialJobRestartCount; restarts > maxRestarts {
+		return v1beta1.PromotionRolledBack, fmt.Sprintf(
+			"the job restarted %d times during the soak window, more than the allowed %d", restarts, maxRestarts)
+	}
+
+	maxFailed := int32OrDefault(policy.MaxFailedCheckpoints, v1beta1.DefaultPromotionMaxFailedCheckpoints)
+	if job.FailedCheckpointCount > maxFailed {
+		return v1beta1.PromotionRolledBack, fmt.Sprintf("%d checkpoints failed, more than the allowed %d",
+			job.FailedCheckpointCount, maxFailed)
+	}
+
+	soakDuration := GetPromotionSoakDuration(policy)
+	if now.Before(status.SoakStartTime.Add(soakDuration)) {
+		return v1beta1.PromotionPending, ""
+	}
+
+	minCompleted := int32OrDefault(policy.MinCompletedCheckpoints, v1beta1.DefaultPromotionMinCompletedCheckpoints)
+	if job.CompletedCheckpointCount < minCompleted {
+		return v1beta1.PromotionRolledBack, fmt.Sprintf("only %d checkpoints completed, fewer than the required %d",
+			job.CompletedCheckpointCount, minCompleted)
+	}
+	if job.Health != v1beta1.Green {
+		return v1beta1.PromotionRolledBack, fmt.Sprintf("the health of the job is %s at the end of the soak window",
+			job.Health)
+	}
+
+	return v1beta1.PromotionPromoted, fmt.Sprintf("the job passed all checks during the soak window of %s",
+		soakDuration)
+}
@@ -0,0 +1,79 @@
+package flink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetPromotionPolicy(t *testing.T) {
+	app := getFlinkTestApp()
+	policy := &v1beta1.PromotionPolicy{}
+	app.Spec.BlueGreen = &v1beta1.BlueGreenConfig{PromotionPolicy: policy}
+	assert.Nil(t, GetPromotionPolicy(&app))
+
+	app.Status.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	assert.Equal(t, policy, GetPromotionPolicy(&app))
+	assert.Equal(t, v1beta1.DefaultPromotionSoakDuration, GetPromotionSoakDuration(policy))
+}
+
+func TestEvaluatePromotion(t *testing.T) {
+	start := time.Date(2019, 9, 1, 10, 0, 0, 0, time.UTC)
+	restarts := int32(1)
+	policy := &v1beta1.PromotionPolicy{
+		SoakDuration:   &metav1.Duration{Duration: 30 * time.Minute},
+		MaxJobRestarts: &restarts,
+	}
+	status := &v1beta1.PromotionStatus{
+		SoakStartTime:          metav1.NewTime(start),
+		InitialJobRestartCount: 1,
+	}
+	job := v1beta1.FlinkJobStatus{
+		State:                    v1beta1.Running,
+		Health:                   v1beta1.Green,
+		JobRestartCount:          2,
+		CompletedCheckpointCount: 3,
+	}
+
+	decision, _ := EvaluatePromotion(policy, status, job, start.Add(10*time.Minute))
+	assert.Equal(t, v1beta1.PromotionPending, decision)
+
+	decision, reason := EvaluatePromotion(policy, status, job, start.Add(30*time.Minute))
+	assert.Equal(t, v1beta1.PromotionPromoted, decision)
+	assert.Equal(t, "the job passed all checks during the soak window of 30m0s", reason)
+
+	// the checks that fail right away
+	restarted := job
+	restarted.JobRestartCount = 3
+	decision, reason = EvaluatePromotion(policy, status, restarted, start.Add(time.Minute))
+	assert.Equal(t, v1beta1.PromotionRolledBack, decision)
+	assert.Equal(t, "the job restarted 2 times during the soak window, more than the allowed 1", reason)
+
+	failedCheckpoints := job
+	failedCheckpoints.FailedCheckpointCount = 1
+	decision, _ = EvaluatePromotion(policy, status, failedCheckpoints, start.Add(time.Minute))
+	assert.Equal(t, v1beta1.PromotionRolledBack, decision)
+
+	failed := job
+	failed.State = v1beta1.Failed
+	decision, reason = EvaluatePromotion(policy, status, failed, start.Add(time.Minute))
+	assert.Equal(t, v1beta1.PromotionRolledBack, decision)
+	assert.Equal(t, "the job has stopped in state FAILED", reason)
+
+	// the checks at the end of the soak window
+	noCheckpoints := job
+	noCheckpoints.CompletedCheckpointCount = 0
+	decision, _ = EvaluatePromotion(policy, status, noCheckpoints, start.Add(10*time.Minute))
+	assert.Equal(t, v1beta1.PromotionPending, decision)
+	decision, reason = EvaluatePromotion(policy, status, noCheckpoints, start.Add(30*time.Minute))
+	assert.Equal(t, v1beta1.PromotionRolledBack, decision)
+	assert.Equal(t, "only 0 checkpoints completed, fewer than the required 1", reason)
+
+	unhealthy := job
+	unhealthy.Health = v1beta1.Yellow
+	decision, _ = EvaluatePromotion(policy, status, unhealthy, start.Add(30*time.Minute))
+	assert.Equal(t, v1beta1.PromotionRolledBack, decision)
+}
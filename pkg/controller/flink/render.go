@@ -0,0 +1,215 @@
+package flink
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/config"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+// The objects the operator creates for the next deploy of an application
+type RenderedApplication struct {
+	Hash                       string
+	JobManagerFlinkConfig      string
+	TaskManagerFlinkConfig     string
+	JobManagerDeployment       *appsv1.Deployment
+	TaskManagerDeployment      *appsv1.Deployment
+	TaskManagerStatefulSet     *appsv1.StatefulSet // set instead of TaskManagerDeployment if configured
+	JobManagerService          *v1.Service
+	VersionedJobManagerService *v1.Service
+	PodDisruptionBudget        *policyv1beta1.PodDisruptionBudget
+	// only set if the cluster has standby job managers
+	JobManagerPodDisruptionBudget *policyv1beta1.PodDisruptionBudget
+	// only set if an ingress URL format is configured
+	JobManagerIngress *extensionsv1beta1.Ingress
+}
+
+// A field of the job manager or task manager deployment that differs between two specs
+type HashChange struct {
+	Component string
+	Path      string
+	Old       string
+	New       string
+}
+
+// Fills in the parts of the status that the state machine sets before creating a cluster, so that the objects are
+// named as they would be on the next deploy
+func prepareForRender(application *v1beta1.FlinkApplication) *v1beta1.FlinkApplication {
+	app := application.DeepCopy()
+	if app.Status.DeploymentMode == "" {
+		app.Status.DeploymentMode = app.Spec.DeploymentMode
+	}
+	if v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
+		app.Status.UpdatingVersion = v1beta1.BlueFlinkApplication
+		if app.Status.DeployVersion == "" || app.Status.DeployVersion == v1beta1.BlueFlinkApplication {
+			app.Status.UpdatingVersion = v1beta1.GreenFlinkApplication
+		}
+	}
+	return app
+}
+
+// Renders the objects that CreateCluster would create for the application, without talking to Kubernetes
+func RenderApplication(application *v1beta1.FlinkApplication) (*RenderedApplication, error) {
+	app := prepareForRender(application)
+	if _, err := renderFlinkConfig(app); err != nil {
+		return nil, err
+	}
+
+	hash := HashForApplication(app)
+	rendered := RenderedApplication{
+		Hash:                 hash,
+		JobManagerDeployment: FetchJobMangerDeploymentCreateObj(app, hash),
+		JobManagerService:    FetchJobManagerServiceCreateObj(app, hash),
+	}
+	rendered.JobManagerFlinkConfig = getFlinkConfigEnv(&rendered.JobManagerDeployment.Spec.Template)
+	if TaskManagersUseStatefulSet(app) {
+		rendered.TaskManagerStatefulSet = FetchTaskManagerStatefulSetCreateObj(app, hash)
+		rendered.TaskManagerFlinkConfig = getFlinkConfigEnv(&rendered.TaskManagerStatefulSet.Spec.Template)
+	} else {
+		rendered.TaskManagerDeployment = FetchTaskMangerDeploymentCreateObj(app, hash)
+		rendered.TaskManagerFlinkConfig = getFlinkConfigEnv(&rendered.TaskManagerDeployment.Spec.Template)
+	}
+
+	rendered.VersionedJobManagerService = FetchJobManagerServiceCreateObj(app, hash)
+	rendered.VersionedJobManagerService.Name = VersionedJobManagerServiceName(app, hash)
+	rendered.VersionedJobManagerService.Labels[FlinkAppHash] = hash
+	rendered.PodDisruptionBudget = FetchPodDisruptionBudgetCreateObj(app, hash)
+	rendered.JobManagerPodDisruptionBudget = FetchJobManagerPodDisruptionBudgetCreateObj(app, hash)
+
+	if config.GetConfig().FlinkIngressURLFormat != "" {
+		rendered.JobManagerIngress = FetchJobManagerIngressCreateObj(app)
+	}
+	return &rendered, nil
+}
+
+func getFlinkConfigEnv(template *v1.PodTemplateSpec) string {
+	for _, container := range template.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Name == OperatorFlinkConfig {
+				return env.Value
+			}
+		}
+	}
+	return ""
+}
+
+// Returns the fields of the templates that HashForApplication is computed from which differ between the two
+// applications, i.e. the changes that cause the new spec to be deployed as a new cluster
+func DiffApplications(oldApplication *v1beta1.FlinkApplication, newApplication *v1beta1.FlinkApplication) ([]HashChange, error) {
+	oldApp := prepareForRender(oldApplication)
+	newApp := prepareForRender(newApplication)
+
+	var changes []HashChange
+	for _, c := range []struct {
+		component string
+		template  func(*v1beta1.FlinkApplication) metav1.Object
+	}{
+		{FlinkDeploymentTypeJobmanager, func(app *v1beta1.FlinkApplication) metav1.Object {
+			return jobmanagerTemplate(app)
+		}},
+		{FlinkDeploymentTypeTaskmanager, taskmanagerObjectTemplate},
+	} {
+		oldFields, err := flattenTemplate(c.template(oldApp))
+		if err != nil {
+			return nil, err
+		}
+		newFields, err := flattenTemplate(c.template(newApp))
+		if err != nil {
+			return nil, err
+		}
+
+		paths := map[string]bool{}
+		for p := range oldFields {
+			paths[p] = true
+		}
+		for p := range newFields {
+			paths[p] = true
+		}
+		sorted := make([]string, 0, len(paths))
+		for p := range paths {
+			if oldFields[p] != newFields[p] {
+				sorted = append(sorted, p)
+			}
+		}
+		sort.Strings(sorted)
+
+		for _, p := range sorted {
+			changes = append(changes, HashChange{
+				Component: c.component,
+				Path:      p,
+				Old:       oldFields[p],
+				New:       newFields[p],
+			})
+		}
+	}
+	return changes, nil
+}
+
+// Flattens the deployment or StatefulSet, normalized the same way as in HashForApplication, into a map from field
+// paths to JSON encoded leaf values
+func flattenTemplate(template metav1.Object) (map[string]string, error) {
+	template.SetOwnerReferences(make([]metav1.OwnerReference, 0))
+	raw, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	var obj interface{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	flatten("", obj, fields)
+	return fields, nil
+}
+
+func flatten(path string, value interface{}, fields map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+			flatten(childPath, child, fields)
+		}
+	case []interface{}:
+		for i, child := range v {
+			// containers, env vars, ports and volumes are identified by their name rather than by their position
+			index := fmt.Sprintf("[%d]", i)
+			if m, ok := child.(map[string]interface{}); ok {
+				if name, ok := m["name"].(string); ok && name != "" {
+					index = fmt.Sprintf("[name=%s]", name)
+				}
+			}
+			flatten(path+index, child, fields)
+		}
+	default:
+		raw, _ := json.Marshal(v)
+		fields[path] = string(raw)
+	}
+}
+
+// Formats the changes returned by DiffApplications, one field per line
+func FormatHashChanges(changes []HashChange) string {
+	var s strings.Builder
+	for _, c := range changes {
+		before, after := c.Old, c.New
+		if before == "" {
+			before = "<unset>"
+		}
+		if after == "" {
+			after = "<unset>"
+		}
+		_, _ = fmt.Fprintf(&s, "%s %s: %s -> %s\n", c.Component, c.Path, before, after)
+	}
+	return s.String()
+}
@@ -0,0 +1,89 @@
+package flink
+
+import (
+	"testing"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderApplication(t *testing.T) {
+	app := getFlinkTestApp()
+	rendered, err := RenderApplication(&app)
+	assert.Nil(t, err)
+
+	hash := HashForApplication(&app)
+	assert.Equal(t, hash, rendered.Hash)
+	assert.Equal(t, FetchJobMangerDeploymentCreateObj(&app, hash), rendered.JobManagerDeployment)
+	assert.Equal(t, FetchTaskMangerDeploymentCreateObj(&app, hash), rendered.TaskManagerDeployment)
+	assert.Equal(t, app.Name, rendered.JobManagerService.Name)
+	assert.Equal(t, VersionedJobManagerServiceName(&app, hash), rendered.VersionedJobManagerService.Name)
+	assert.Equal(t, hash, rendered.VersionedJobManagerService.Labels[FlinkAppHash])
+	assert.Equal(t, FetchPodDisruptionBudgetCreateObj(&app, hash), rendered.PodDisruptionBudget)
+	assert.Nil(t, rendered.JobManagerPodDisruptionBudget)
+	assert.Nil(t, rendered.JobManagerIngress)
+
+	assert.Contains(t, rendered.JobManagerFlinkConfig, "jobmanager.rpc.address: "+VersionedJobManagerServiceName(&app, hash))
+	assert.Contains(t, rendered.TaskManagerFlinkConfig, "taskmanager.host: $HOST_IP")
+
+	app.Spec.FlinkConfig = v1beta1.FlinkConfig{"akka.timeout": map[string]interface{}{"value": "5s"}}
+	_, err = RenderApplication(&app)
+	assert.NotNil(t, err)
+}
+
+func TestRenderBlueGreenApplication(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+
+	rendered, err := RenderApplication(&app)
+	assert.Nil(t, err)
+	assert.Equal(t, testAppName+"-green", rendered.JobManagerService.Name)
+	// the application is not modified
+	assert.Equal(t, v1beta1.FlinkApplicationVersion(""), app.Status.UpdatingVersion)
+
+	app.Status.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	app.Status.DeployVersion = v1beta1.GreenFlinkApplication
+	rendered, err = RenderApplication(&app)
+	assert.Nil(t, err)
+	assert.Equal(t, testAppName+"-blue", rendered.JobManagerService.Name)
+}
+
+func TestDiffApplications(t *testing.T) {
+	oldApp := getFlinkTestApp()
+	newApp := getFlinkTestApp()
+
+	changes, err := DiffApplications(&oldApp, &newApp)
+	assert.Nil(t, err)
+	assert.Empty(t, changes)
+
+	newApp.Spec.Image = "flink:1.9"
+	changes, err = DiffApplications(&oldApp, &newApp)
+	assert.Nil(t, err)
+	assert.Equal(t, []HashChange{
+		{
+			Component: FlinkDeploymentTypeJobmanager,
+			Path:      "spec.template.spec.containers[name=jobmanager].image",
+			Old:       `"` + testImage + `"`,
+			New:       `"flink:1.9"`,
+		},
+		{
+			Component: FlinkDeploymentTypeTaskmanager,
+			Path:      "spec.template.spec.containers[name=taskmanager].image",
+			Old:       `"` + testImage + `"`,
+			New:       `"flink:1.9"`,
+		},
+	}, changes)
+	assert.Equal(t,
+		"jobmanager spec.template.spec.containers[name=jobmanager].image: \""+testImage+"\" -> \"flink:1.9\"\n"+
+			"taskmanager spec.template.spec.containers[name=taskmanager].image: \""+testImage+"\" -> \"flink:1.9\"\n",
+		FormatHashChanges(changes))
+
+	newApp = getFlinkTestApp()
+	newApp.Spec.RestartNonce = "restart"
+	changes, err = DiffApplications(&oldApp, &newApp)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(changes))
+	assert.Equal(t, "metadata.annotations.restart-nonce", changes[0].Path)
+	assert.Equal(t, "", changes[0].Old)
+	assert.Equal(t, `"restart"`, changes[0].New)
+}
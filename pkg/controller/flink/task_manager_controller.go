@@ -15,8 +15,8 @@ import (
 	v1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
 	k8_err "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 const (
@@ -60,22 +60,16 @@ type taskManagerMetrics struct {
 	deploymentCreationFailure labeled.Counter
 }
 
-var TaskManagerDefaultResources = coreV1.ResourceRequirements{
-	Requests: coreV1.ResourceList{
-		coreV1.ResourceCPU:    resource.MustParse("2"),
-		coreV1.ResourceMemory: resource.MustParse("1024Mi"),
-	},
-	Limits: coreV1.ResourceList{
-		coreV1.ResourceCPU:    resource.MustParse("2"),
-		coreV1.ResourceMemory: resource.MustParse("1024Mi"),
-	},
-}
+var TaskManagerDefaultResources = v1beta1.DefaultTaskManagerResources
 
 func (t *TaskManagerController) CreateIfNotExist(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
 	hash := HashForApplication(application)
 
-	taskManagerDeployment := FetchTaskMangerDeploymentCreateObj(application, hash)
-	err := t.k8Cluster.CreateK8Object(ctx, taskManagerDeployment)
+	var taskManager runtime.Object = FetchTaskMangerDeploymentCreateObj(application, hash)
+	if TaskManagersUseStatefulSet(application) {
+		taskManager = FetchTaskManagerStatefulSetCreateObj(application, hash)
+	}
+	err := t.k8Cluster.CreateK8Object(ctx, taskManager)
 	if err != nil {
 		if !k8_err.IsAlreadyExists(err) {
 			logger.Errorf(ctx, "Taskmanager deployment creation failed %v", err)
@@ -137,7 +131,7 @@ func FetchTaskManagerContainerObj(application *v1beta1.FlinkApplication) *coreV1
 		Ports:           ports,
 		Env:             operatorEnv,
 		EnvFrom:         tmConfig.EnvConfig.EnvFrom,
-		VolumeMounts:    application.Spec.VolumeMounts,
+		VolumeMounts:    mergeVolumeMounts(application.Spec.VolumeMounts, tmConfig.VolumeMounts),
 	}
 }
 
@@ -157,7 +151,7 @@ func getTaskManagerName(application *v1beta1.FlinkApplication, hash string) stri
 
 func computeTaskManagerReplicas(application *v1beta1.FlinkApplication) int32 {
 	slots := getTaskmanagerSlots(application)
-	parallelism := application.Spec.Parallelism
+	parallelism := GetParallelism(application)
 	return int32(math.Ceil(float64(parallelism) / float64(slots)))
 }
 
@@ -205,13 +199,13 @@ func taskmanagerTemplate(app *v1beta1.FlinkApplication) *v1.Deployment {
 				ObjectMeta: metaV1.ObjectMeta{
 					Namespace:   app.Namespace,
 					Labels:      labels,
-					Annotations: app.Annotations,
+					Annotations: getPodAnnotations(app),
 				},
 				Spec: coreV1.PodSpec{
-					Containers: []coreV1.Container{
-						*taskContainer,
-					},
-					Volumes:          app.Spec.Volumes,
+					Containers: append([]coreV1.Container{*taskContainer},
+						withVolumeMounts(taskContainer.VolumeMounts, app.Spec.TaskManagerConfig.Sidecars)...),
+					InitContainers:   withVolumeMounts(taskContainer.VolumeMounts, app.Spec.TaskManagerConfig.InitContainers),
+					Volumes:          mergeVolumes(app.Spec.Volumes, app.Spec.TaskManagerConfig.Volumes),
 					ImagePullSecrets: app.Spec.ImagePullSecrets,
 					NodeSelector:     app.Spec.TaskManagerConfig.NodeSelector,
 					Tolerations:      app.Spec.TaskManagerConfig.Tolerations,
@@ -229,6 +223,9 @@ func taskmanagerTemplate(app *v1beta1.FlinkApplication) *v1.Deployment {
 		deployment.Spec.Template.Spec.SecurityContext = app.Spec.SecurityContext
 	}
 
+	applyPodTemplate(&deployment.Spec.Template, app.Spec.TaskManagerConfig.PodTemplate,
+		getFlinkContainerName(TaskManagerContainerName))
+
 	return deployment
 }
 
@@ -246,6 +243,53 @@ func FetchTaskMangerDeploymentCreateObj(app *v1beta1.FlinkApplication, hash stri
 	return template
 }
 
+func TaskManagersUseStatefulSet(app *v1beta1.FlinkApplication) bool {
+	return app.Spec.TaskManagerConfig.StatefulSet != nil
+}
+
+func StatefulSetIsTaskmanager(statefulSet *v1.StatefulSet) bool {
+	return statefulSet.Labels[FlinkDeploymentType] == FlinkDeploymentTypeTaskmanager
+}
+
+// Task managers that run as a StatefulSet use the same pod template as the deployment they would otherwise run as.
+// Each of them gets its own claims from the volumeClaimTemplates, which keep their name when the pod is rescheduled.
+func taskmanagerStatefulSet(app *v1beta1.FlinkApplication, deployment *v1.Deployment) *v1.StatefulSet {
+	return &v1.StatefulSet{
+		TypeMeta: metaV1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       k8.StatefulSet,
+		},
+		ObjectMeta: deployment.ObjectMeta,
+		Spec: v1.StatefulSetSpec{
+			Selector: deployment.Spec.Selector,
+			Replicas: deployment.Spec.Replicas,
+			Template: deployment.Spec.Template,
+			// task managers are reached by their IP, so the governing service does not need to exist
+			ServiceName: deployment.Name,
+			// the job needs all task managers, so there is no point in starting them one by one
+			PodManagementPolicy:  v1.ParallelPodManagement,
+			VolumeClaimTemplates: app.Spec.TaskManagerConfig.StatefulSet.VolumeClaimTemplates,
+		},
+	}
+}
+
+// Returns the StatefulSet the task managers are created from if the application uses one, and the deployment otherwise
+func taskmanagerObjectTemplate(app *v1beta1.FlinkApplication) metaV1.Object {
+	deployment := taskmanagerTemplate(app)
+	if TaskManagersUseStatefulSet(app) {
+		return taskmanagerStatefulSet(app, deployment)
+	}
+	return deployment
+}
+
+func FetchTaskManagerStatefulSetCreateObj(app *v1beta1.FlinkApplication, hash string) *v1.StatefulSet {
+	return taskmanagerStatefulSet(app, FetchTaskMangerDeploymentCreateObj(app, hash))
+}
+
+func TaskManagerStatefulSetMatches(statefulSet *v1.StatefulSet, application *v1beta1.FlinkApplication, hash string) bool {
+	return statefulSet.Name == getTaskManagerName(application, hash)
+}
+
 func TaskManagerDeploymentMatches(deployment *v1.Deployment, application *v1beta1.FlinkApplication, hash string) bool {
 	deploymentName := getTaskManagerName(application, hash)
 	return deployment.Name == deploymentName
@@ -16,6 +16,7 @@ import (
 	v1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -285,3 +286,49 @@ func TestTaskManagerCreateSuccessWithVersion(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, newlyCreated)
 }
+
+func TestTaskManagerCreateStatefulSet(t *testing.T) {
+	testController := getTMControllerForTest()
+	app := getFlinkTestApp()
+	claim := coreV1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "local-state"},
+	}
+	app.Spec.TaskManagerConfig.StatefulSet = &v1beta1.TaskManagerStatefulSetConfig{
+		VolumeClaimTemplates: []coreV1.PersistentVolumeClaim{claim},
+	}
+	app.Spec.TaskManagerConfig.VolumeMounts = []coreV1.VolumeMount{{Name: "local-state", MountPath: "/state"}}
+	hash := HashForApplication(&app)
+
+	mockK8Cluster := testController.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.CreateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		statefulSet := object.(*v1.StatefulSet)
+		deployment := FetchTaskMangerDeploymentCreateObj(&app, hash)
+		assert.Equal(t, getTaskManagerName(&app, hash), statefulSet.Name)
+		assert.Equal(t, getTaskManagerName(&app, hash), statefulSet.Spec.ServiceName)
+		assert.Equal(t, deployment.Labels, statefulSet.Labels)
+		assert.Equal(t, deployment.Spec.Template, statefulSet.Spec.Template)
+		assert.Equal(t, deployment.Spec.Selector, statefulSet.Spec.Selector)
+		assert.Equal(t, int32(1), *statefulSet.Spec.Replicas)
+		assert.Equal(t, v1.ParallelPodManagement, statefulSet.Spec.PodManagementPolicy)
+		assert.Equal(t, []coreV1.PersistentVolumeClaim{claim}, statefulSet.Spec.VolumeClaimTemplates)
+		assert.True(t, TaskManagerStatefulSetMatches(statefulSet, &app, hash))
+		return nil
+	}
+	newlyCreated, err := testController.CreateIfNotExist(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, newlyCreated)
+}
+
+func TestHashForStatefulSet(t *testing.T) {
+	app := getFlinkTestApp()
+	h1 := HashForApplication(&app)
+
+	app.Spec.TaskManagerConfig.StatefulSet = &v1beta1.TaskManagerStatefulSetConfig{}
+	h2 := HashForApplication(&app)
+	assert.NotEqual(t, h1, h2)
+
+	app.Spec.TaskManagerConfig.StatefulSet.VolumeClaimTemplates = []coreV1.PersistentVolumeClaim{
+		{ObjectMeta: metav1.ObjectMeta{Name: "local-state"}},
+	}
+	assert.NotEqual(t, h2, HashForApplication(&app))
+}
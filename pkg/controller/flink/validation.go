@@ -0,0 +1,608 @@
+package flink
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/config"
+	"github.com/lyft/flinkk8soperator/pkg/controller/savepoint"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var supportedDeploymentModes = []string{
+	string(v1beta1.DeploymentModeDual),
+	string(v1beta1.DeploymentModeBlueGreen),
+}
+
+var supportedJarURISchemes = []string{"http", "https", "s3", "gs"}
+
+var supportedExecutionModes = []string{
+	string(v1beta1.ExecutionModeSession),
+	string(v1beta1.ExecutionModeApplication),
+}
+
+var supportedAutoscalerMetrics = []string{
+	string(v1beta1.AutoscalerMetricBusyTime),
+	string(v1beta1.AutoscalerMetricBackpressure),
+	string(v1beta1.AutoscalerMetricKafkaLag),
+}
+
+// Scheduled savepoints are only checked for on each reconciliation of the application, so shorter intervals would not
+// be honored
+const minSavepointInterval = time.Minute
+
+var supportedDeleteModes = []string{
+	string(v1beta1.DeleteModeSavepoint),
+	string(v1beta1.DeleteModeForceCancel),
+	string(v1beta1.DeleteModeNone),
+}
+
+var supportedDesiredStates = []string{
+	string(v1beta1.DesiredStateRunning),
+	string(v1beta1.DesiredStateSuspended),
+}
+
+func isSupported(value string, supported []string) bool {
+	for _, s := range supported {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
+
+func validateFraction(fraction *float64, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if fraction != nil && (*fraction < 0 || *fraction > 1) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *fraction, "must be between 0 and 1"))
+	}
+	return allErrs
+}
+
+// Flink limits the length of kubernetes.cluster-id, as it is used as a prefix for the names of the HA ConfigMaps
+const maxHAClusterIDLength = 45
+
+func validateHighAvailability(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if app.Spec.HighAvailability == nil {
+		return allErrs
+	}
+
+	if app.Spec.HighAvailability.StorageDir == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("storageDir"), ""))
+	}
+
+	if _, ok := app.Spec.FlinkConfig[HighAvailabilityKey]; ok {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "flinkConfig").Key(HighAvailabilityKey),
+			"may not be set together with spec.highAvailability"))
+	}
+
+	// the HA services create and watch ConfigMaps, which the default service account is not allowed to do
+	if app.Spec.ServiceAccountName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "serviceAccountName"),
+			"a service account that may manage ConfigMaps is required for high availability"))
+	}
+
+	if clusterID := getHAClusterID(app, "00000000"); len(clusterID) > maxHAClusterIDLength {
+		allErrs = append(allErrs, field.TooLong(field.NewPath("metadata", "name"), app.Name,
+			maxHAClusterIDLength-len(clusterID)+len(app.Name)))
+	}
+
+	return allErrs
+}
+
+func validateAutoscaler(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	autoscaler := app.Spec.Autoscaler
+	if autoscaler == nil {
+		return allErrs
+	}
+
+	if autoscaler.MinParallelism < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minParallelism"), autoscaler.MinParallelism,
+			"must be at least 1"))
+	}
+	if autoscaler.MaxParallelism < autoscaler.MinParallelism {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallelism"), autoscaler.MaxParallelism,
+			"must not be less than minParallelism"))
+	} else if slots, maxTaskManagers := getTaskmanagerSlots(app), config.GetConfig().MaxTaskManagers; slots > 0 &&
+		maxTaskManagers > 0 && int64(autoscaler.MaxParallelism) > int64(slots)*int64(maxTaskManagers) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallelism"), autoscaler.MaxParallelism,
+			fmt.Sprintf("exceeds the %d task slots available with at most %d task managers of %d slots",
+				int64(slots)*int64(maxTaskManagers), maxTaskManagers, slots)))
+	}
+
+	if app.Spec.Parallelism != 0 &&
+		(app.Spec.Parallelism < autoscaler.MinParallelism || app.Spec.Parallelism > autoscaler.MaxParallelism) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "parallelism"), app.Spec.Parallelism,
+			"must be between the minParallelism and maxParallelism of the autoscaler"))
+	}
+
+	if target := autoscaler.TargetUtilization; target != nil && (*target <= 0 || *target > 1) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetUtilization"), *target,
+			"must be greater than 0 and at most 1"))
+	}
+
+	for i, metric := range autoscaler.Metrics {
+		if !isSupported(string(metric), supportedAutoscalerMetrics) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("metrics").Index(i), metric,
+				supportedAutoscalerMetrics))
+		} else if metric == v1beta1.AutoscalerMetricKafkaLag &&
+			(autoscaler.MaxKafkaLag == nil || *autoscaler.MaxKafkaLag <= 0) {
+			allErrs = append(allErrs, field.Required(fldPath.Child("maxKafkaLag"),
+				"a positive maxKafkaLag is required for the KafkaLag metric"))
+		}
+	}
+
+	if v1beta1.IsBlueGreenDeploymentMode(app.Spec.DeploymentMode) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			"the autoscaler is not supported with the BlueGreen deployment mode"))
+	}
+
+	return allErrs
+}
+
+func validateSavepointSchedule(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	schedule := app.Spec.SavepointSchedule
+	if schedule == nil {
+		return allErrs
+	}
+
+	if schedule.Interval == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("interval"), ""))
+	} else if schedule.Interval.Duration < minSavepointInterval {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), schedule.Interval.Duration.String(),
+			fmt.Sprintf("must be at least %s", minSavepointInterval)))
+	}
+
+	if schedule.RetainCount != nil && *schedule.RetainCount < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retainCount"), *schedule.RetainCount,
+			"must be at least 1"))
+	}
+	if schedule.RetainFor != nil && schedule.RetainFor.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retainFor"), schedule.RetainFor.Duration.String(),
+			"must be positive"))
+	}
+	if schedule.DeleteExpired {
+		// the scheduled savepoints are written to the default savepoint directory of the cluster
+		dir, _ := app.Spec.FlinkConfig[SavepointDirKey].(string)
+		if dir == "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("deleteExpired"),
+				fmt.Sprintf("requires %s to be set in flinkConfig", SavepointDirKey)))
+		} else if !savepoint.NewStorage().Supports(dir) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("deleteExpired"),
+				fmt.Sprintf("deleting savepoints is not supported for %s %s", SavepointDirKey, dir)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateRestoreFrom(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	restoreFrom := app.Spec.RestoreFrom
+	if restoreFrom == nil {
+		return allErrs
+	}
+
+	if restoreFrom.Index != nil && restoreFrom.Hash != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("hash"), "only one of index and hash may be set"))
+	} else if restoreFrom.Index != nil {
+		if *restoreFrom.Index < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("index"), *restoreFrom.Index,
+				"must be non-negative"))
+		}
+	} else if restoreFrom.Hash == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "one of index and hash must be set"))
+	}
+
+	return allErrs
+}
+
+func validatePromotionPolicy(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if app.Spec.BlueGreen == nil || app.Spec.BlueGreen.PromotionPolicy == nil {
+		return allErrs
+	}
+	policy := app.Spec.BlueGreen.PromotionPolicy
+
+	if !v1beta1.IsBlueGreenDeploymentMode(app.Spec.DeploymentMode) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			"a promotion policy is only supported with the BlueGreen deployment mode"))
+	}
+	if policy.SoakDuration != nil && policy.SoakDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("soakDuration"), policy.SoakDuration.Duration.String(),
+			"must be positive"))
+	}
+	counts := []struct {
+		name  string
+		value *int32
+	}{
+		{"maxJobRestarts", policy.MaxJobRestarts},
+		{"minCompletedCheckpoints", policy.MinCompletedCheckpoints},
+		{"maxFailedCheckpoints", policy.MaxFailedCheckpoints},
+	}
+	for _, count := range counts {
+		if count.value != nil && *count.value < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(count.name), *count.value, "must be non-negative"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAutoRollback(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	policy := app.Spec.AutoRollback
+	if policy == nil {
+		return allErrs
+	}
+
+	// the previous job is submitted again to the cluster of the previous version, which is only kept in Dual mode
+	if v1beta1.IsBlueGreenDeploymentMode(app.Spec.DeploymentMode) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			"auto-rollback is not supported with the BlueGreen deployment mode, use spec.blueGreen.promotionPolicy"))
+	}
+	if v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			"auto-rollback is not supported with the Application execution mode"))
+	}
+
+	durations := []struct {
+		name  string
+		value *metav1.Duration
+	}{
+		{"window", policy.Window},
+		{"taskManagerHeartbeatTimeout", policy.TaskManagerHeartbeatTimeout},
+	}
+	for _, duration := range durations {
+		if duration.value != nil && duration.value.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(duration.name), duration.value.Duration.String(),
+				"must be positive"))
+		}
+	}
+	counts := []struct {
+		name  string
+		value *int32
+	}{
+		{"maxJobRestarts", policy.MaxJobRestarts},
+		{"maxConsecutiveFailedCheckpoints", policy.MaxConsecutiveFailedCheckpoints},
+	}
+	for _, count := range counts {
+		if count.value != nil && *count.value < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(count.name), *count.value, "must be non-negative"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateBoundedJob(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	config := app.Spec.BoundedJob
+	if config == nil {
+		return allErrs
+	}
+
+	if ttl := config.TTLAfterFinished; ttl != nil && ttl.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttlAfterFinished"), ttl.Duration.String(),
+			"must be non-negative"))
+	}
+
+	return allErrs
+}
+
+func validatePodDisruptionBudget(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	config := app.Spec.PodDisruptionBudget
+	if config == nil || config.MaxUnavailable == nil {
+		return allErrs
+	}
+
+	maxUnavailable := config.MaxUnavailable
+	// with a total of 100, percentages resolve to their own value
+	value, err := intstr.GetValueFromIntOrPercent(maxUnavailable, 100, true)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable.String(),
+			"must be an integer or a percentage"))
+	} else if value < 0 || (maxUnavailable.Type == intstr.String && value > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), maxUnavailable.String(),
+			"must be non-negative, and at most 100%"))
+	}
+
+	return allErrs
+}
+
+func validateSessionJobs(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(app.Spec.Jobs) == 0 {
+		return allErrs
+	}
+
+	// the jobs are moved to the new cluster of a deploy with their savepoints, which needs a single cluster to be
+	// running at a time, and a session cluster to submit them to
+	if v1beta1.IsBlueGreenDeploymentMode(app.Spec.DeploymentMode) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			"additional jobs are not supported with the BlueGreen deployment mode"))
+	}
+	if v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			"additional jobs are not supported with the Application execution mode"))
+	}
+
+	names := map[string]bool{}
+	for i, job := range app.Spec.Jobs {
+		jobPath := fldPath.Index(i)
+		if job.Name == "" {
+			allErrs = append(allErrs, field.Required(jobPath.Child("name"), ""))
+		} else if names[job.Name] {
+			allErrs = append(allErrs, field.Duplicate(jobPath.Child("name"), job.Name))
+		}
+		names[job.Name] = true
+
+		if job.JarName == "" {
+			allErrs = append(allErrs, field.Required(jobPath.Child("jarName"), ""))
+		}
+		if job.Parallelism < 1 {
+			allErrs = append(allErrs, field.Invalid(jobPath.Child("parallelism"), job.Parallelism,
+				"must be at least 1"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateJarURI(app *v1beta1.FlinkApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if app.Spec.JarURI == "" {
+		if app.Spec.JarChecksum != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("jarChecksum"), "requires jarURI to be set"))
+		}
+		return allErrs
+	}
+
+	// in application mode the job manager starts the job from a jar in its image, so there is nothing to upload to
+	if v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("jarURI"),
+			"jarURI is not supported with the Application execution mode"))
+	}
+
+	u, err := url.Parse(app.Spec.JarURI)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jarURI"), app.Spec.JarURI, err.Error()))
+	} else if !isSupported(u.Scheme, supportedJarURISchemes) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("jarURI"), u.Scheme, supportedJarURISchemes))
+	} else if !isJarURIAllowed(u) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("jarURI"),
+			"the host of the jar URI is not in the operator's jarUriAllowlist"))
+	}
+
+	if checksum := app.Spec.JarChecksum; checksum != "" {
+		if _, err := hex.DecodeString(checksum); err != nil || len(checksum) != sha256.Size*2 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("jarChecksum"), checksum,
+				"must be a hex-encoded SHA-256 digest"))
+		}
+	}
+
+	return allErrs
+}
+
+// The volumes of the job manager or task manager config, and the volume claim templates of the task managers'
+// StatefulSet, need unique names. Their volume mounts need to refer to one of these or to one of the application's
+// volumes.
+func validateComponentVolumes(app *v1beta1.FlinkApplication, volumes []coreV1.Volume,
+	claims []coreV1.PersistentVolumeClaim, mounts []coreV1.VolumeMount, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := map[string]bool{}
+	for i, volume := range volumes {
+		namePath := fldPath.Child("volumes").Index(i).Child("name")
+		if volume.Name == "" {
+			allErrs = append(allErrs, field.Required(namePath, ""))
+		} else if names[volume.Name] {
+			allErrs = append(allErrs, field.Duplicate(namePath, volume.Name))
+		}
+		names[volume.Name] = true
+	}
+	for i, claim := range claims {
+		namePath := fldPath.Child("statefulSet", "volumeClaimTemplates").Index(i).Child("metadata", "name")
+		if claim.Name == "" {
+			allErrs = append(allErrs, field.Required(namePath, ""))
+		} else if names[claim.Name] {
+			allErrs = append(allErrs, field.Duplicate(namePath, claim.Name))
+		}
+		names[claim.Name] = true
+	}
+	for _, volume := range app.Spec.Volumes {
+		names[volume.Name] = true
+	}
+
+	paths := map[string]bool{}
+	for i, mount := range mounts {
+		idxPath := fldPath.Child("volumeMounts").Index(i)
+		if !names[mount.Name] {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), mount.Name))
+		}
+		if mount.MountPath == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("mountPath"), ""))
+		} else if paths[mount.MountPath] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("mountPath"), mount.MountPath))
+		}
+		paths[mount.MountPath] = true
+	}
+	return allErrs
+}
+
+// Sidecars and init containers need unique names, which may not collide with the Flink container
+func validateContainers(containers []coreV1.Container, names map[string]bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, container := range containers {
+		idxPath := fldPath.Index(i)
+		if container.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else if names[container.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), container.Name))
+		}
+		names[container.Name] = true
+
+		if container.Image == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("image"), ""))
+		}
+	}
+	return allErrs
+}
+
+func validateAuxiliaryContainers(sidecars []coreV1.Container, initContainers []coreV1.Container,
+	flinkContainerName string, fldPath *field.Path) field.ErrorList {
+	names := map[string]bool{flinkContainerName: true}
+	allErrs := validateContainers(sidecars, names, fldPath.Child("sidecars"))
+	return append(allErrs, validateContainers(initContainers, names, fldPath.Child("initContainers"))...)
+}
+
+// Containers, init containers and volumes of the podTemplate are merged into the generated pod by name
+func validatePodTemplate(podTemplate *coreV1.PodTemplateSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if podTemplate == nil {
+		return allErrs
+	}
+
+	specPath := fldPath.Child("spec")
+	for i, container := range podTemplate.Spec.Containers {
+		if container.Name == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("containers").Index(i).Child("name"), ""))
+		}
+	}
+	for i, container := range podTemplate.Spec.InitContainers {
+		if container.Name == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("initContainers").Index(i).Child("name"), ""))
+		}
+	}
+	for i, volume := range podTemplate.Spec.Volumes {
+		if volume.Name == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("volumes").Index(i).Child("name"), ""))
+		}
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	if _, err := mergePodTemplate(&coreV1.PodTemplateSpec{}, podTemplate, ""); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", err.Error()))
+	}
+	return allErrs
+}
+
+// Validates a FlinkApplication before it is accepted by the operator. This catches specs that would otherwise
+// only fail once a cluster has been created for them.
+func ValidateApplication(app *v1beta1.FlinkApplication) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if app.Spec.Parallelism < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("parallelism"), app.Spec.Parallelism,
+			"must be non-negative"))
+	}
+
+	slots := getTaskmanagerSlots(app)
+	if slots < 1 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("taskManagerConfig", "taskSlots"), slots,
+			"must be at least 1"))
+	} else if maxTaskManagers := config.GetConfig().MaxTaskManagers; maxTaskManagers > 0 &&
+		int64(app.Spec.Parallelism) > int64(slots)*int64(maxTaskManagers) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("parallelism"), app.Spec.Parallelism,
+			fmt.Sprintf("exceeds the %d task slots available with at most %d task managers of %d slots",
+				int64(slots)*int64(maxTaskManagers), maxTaskManagers, slots)))
+	}
+
+	allErrs = append(allErrs, validateFraction(app.Spec.JobManagerConfig.OffHeapMemoryFraction,
+		specPath.Child("jobManagerConfig", "offHeapMemoryFraction"))...)
+	allErrs = append(allErrs, validateFraction(app.Spec.TaskManagerConfig.OffHeapMemoryFraction,
+		specPath.Child("taskManagerConfig", "offHeapMemoryFraction"))...)
+
+	if app.Spec.DeploymentMode != "" && !isSupported(string(app.Spec.DeploymentMode), supportedDeploymentModes) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("deploymentMode"), app.Spec.DeploymentMode,
+			supportedDeploymentModes))
+	}
+
+	if app.Spec.ExecutionMode != "" && !isSupported(string(app.Spec.ExecutionMode), supportedExecutionModes) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("executionMode"), app.Spec.ExecutionMode,
+			supportedExecutionModes))
+	} else if v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) &&
+		v1beta1.IsBlueGreenDeploymentMode(app.Spec.DeploymentMode) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("executionMode"),
+			"the Application execution mode is not supported with the BlueGreen deployment mode"))
+	}
+
+	if app.Spec.DeleteMode != "" && !isSupported(string(app.Spec.DeleteMode), supportedDeleteModes) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("deleteMode"), app.Spec.DeleteMode,
+			supportedDeleteModes))
+	}
+
+	if app.Spec.DesiredState != "" && !isSupported(string(app.Spec.DesiredState), supportedDesiredStates) {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("desiredState"), app.Spec.DesiredState,
+			supportedDesiredStates))
+	}
+
+	allErrs = append(allErrs, validateHighAvailability(app, specPath.Child("highAvailability"))...)
+	allErrs = append(allErrs, validateAutoscaler(app, specPath.Child("autoscaler"))...)
+	allErrs = append(allErrs, validateSavepointSchedule(app, specPath.Child("savepointSchedule"))...)
+	allErrs = append(allErrs, validateRestoreFrom(app, specPath.Child("restoreFrom"))...)
+	allErrs = append(allErrs, validatePromotionPolicy(app, specPath.Child("blueGreen", "promotionPolicy"))...)
+	allErrs = append(allErrs, validateAutoRollback(app, specPath.Child("autoRollback"))...)
+	allErrs = append(allErrs, validateBoundedJob(app, specPath.Child("boundedJob"))...)
+	allErrs = append(allErrs, validateSessionJobs(app, specPath.Child("jobs"))...)
+	allErrs = append(allErrs, validatePodDisruptionBudget(app, specPath.Child("podDisruptionBudget"))...)
+	allErrs = append(allErrs, validateJarURI(app, specPath)...)
+	allErrs = append(allErrs, validateComponentVolumes(app, app.Spec.JobManagerConfig.Volumes, nil,
+		app.Spec.JobManagerConfig.VolumeMounts, specPath.Child("jobManagerConfig"))...)
+	var claims []coreV1.PersistentVolumeClaim
+	if TaskManagersUseStatefulSet(app) {
+		claims = app.Spec.TaskManagerConfig.StatefulSet.VolumeClaimTemplates
+	}
+	allErrs = append(allErrs, validateComponentVolumes(app, app.Spec.TaskManagerConfig.Volumes, claims,
+		app.Spec.TaskManagerConfig.VolumeMounts, specPath.Child("taskManagerConfig"))...)
+	allErrs = append(allErrs, validateAuxiliaryContainers(app.Spec.JobManagerConfig.Sidecars,
+		app.Spec.JobManagerConfig.InitContainers, getFlinkContainerName(JobManagerContainerName),
+		specPath.Child("jobManagerConfig"))...)
+	allErrs = append(allErrs, validateAuxiliaryContainers(app.Spec.TaskManagerConfig.Sidecars,
+		app.Spec.TaskManagerConfig.InitContainers, getFlinkContainerName(TaskManagerContainerName),
+		specPath.Child("taskManagerConfig"))...)
+	allErrs = append(allErrs, validatePodTemplate(app.Spec.JobManagerConfig.PodTemplate,
+		specPath.Child("jobManagerConfig", "podTemplate"))...)
+	allErrs = append(allErrs, validatePodTemplate(app.Spec.TaskManagerConfig.PodTemplate,
+		specPath.Child("taskManagerConfig", "podTemplate"))...)
+
+	if _, err := renderFlinkConfig(app); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("flinkConfig"), "", err.Error()))
+	}
+
+	return allErrs
+}
+
+// Validates an update to an existing FlinkApplication. In addition to the checks performed on creation, this
+// rejects changes that the state machine is not able to perform.
+func ValidateApplicationUpdate(app *v1beta1.FlinkApplication, old *v1beta1.FlinkApplication) field.ErrorList {
+	allErrs := ValidateApplication(app)
+
+	// the deployment mode of a running application is recorded in its status; fall back to the spec for
+	// applications that have not yet been handled by the operator
+	oldMode := old.Status.DeploymentMode
+	if oldMode == "" {
+		oldMode = old.Spec.DeploymentMode
+	}
+
+	if v1beta1.IsBlueGreenDeploymentMode(oldMode) != v1beta1.IsBlueGreenDeploymentMode(app.Spec.DeploymentMode) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "deploymentMode"),
+			fmt.Sprintf("changing deployment mode from %s to %s is unsupported", oldMode, app.Spec.DeploymentMode)))
+	}
+
+	return allErrs
+}
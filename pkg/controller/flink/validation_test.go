@@ -0,0 +1,478 @@
+package flink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/config"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateApplication(t *testing.T) {
+	app := getFlinkTestApp()
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateNegativeParallelism(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.Parallelism = -1
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+	assert.Equal(t, "spec.parallelism", errs[0].Field)
+}
+
+func TestValidateParallelismExceedsTaskSlots(t *testing.T) {
+	err := config.ConfigSection.SetConfig(&config.Config{
+		MaxTaskManagers: 2,
+	})
+	assert.Nil(t, err)
+	defer func() {
+		_ = config.ConfigSection.SetConfig(&config.Config{})
+	}()
+
+	slots := int32(4)
+	app := getFlinkTestApp()
+	app.Spec.TaskManagerConfig.TaskSlots = &slots
+
+	app.Spec.Parallelism = 8
+	assert.Empty(t, ValidateApplication(&app))
+
+	app.Spec.Parallelism = 9
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "spec.parallelism", errs[0].Field)
+}
+
+func TestValidateOffHeapMemoryFraction(t *testing.T) {
+	jmFraction := 1.5
+	tmFraction := -0.1
+	app := getFlinkTestApp()
+	app.Spec.JobManagerConfig.OffHeapMemoryFraction = &jmFraction
+	app.Spec.TaskManagerConfig.OffHeapMemoryFraction = &tmFraction
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, "spec.jobManagerConfig.offHeapMemoryFraction", errs[0].Field)
+	assert.Equal(t, "spec.taskManagerConfig.offHeapMemoryFraction", errs[1].Field)
+}
+
+func TestValidateUnsupportedModes(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.DeploymentMode = "Canary"
+	app.Spec.ExecutionMode = "Local"
+	app.Spec.DeleteMode = "Drain"
+	app.Spec.DesiredState = "Paused"
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 4, len(errs))
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+	assert.Equal(t, "spec.deploymentMode", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[1].Type)
+	assert.Equal(t, "spec.executionMode", errs[1].Field)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[2].Type)
+	assert.Equal(t, "spec.deleteMode", errs[2].Field)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[3].Type)
+	assert.Equal(t, "spec.desiredState", errs[3].Field)
+}
+
+func TestValidateApplicationModeWithBlueGreen(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.ExecutionMode = v1beta1.ExecutionModeApplication
+	assert.Empty(t, ValidateApplication(&app))
+
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.executionMode", errs[0].Field)
+}
+
+func TestValidateHighAvailability(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.ServiceAccountName = "flink"
+	app.Spec.HighAvailability = &v1beta1.HighAvailabilityConfig{StorageDir: "s3://flink/ha"}
+	assert.Empty(t, ValidateApplication(&app))
+
+	app.Name = "an-application-name-that-is-much-too-long"
+	app.Spec.ServiceAccountName = ""
+	app.Spec.HighAvailability.StorageDir = ""
+	app.Spec.FlinkConfig = map[string]interface{}{"high-availability": "zookeeper"}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 4, len(errs))
+	assert.Equal(t, "spec.highAvailability.storageDir", errs[0].Field)
+	assert.Equal(t, "spec.flinkConfig[high-availability]", errs[1].Field)
+	assert.Equal(t, "spec.serviceAccountName", errs[2].Field)
+	assert.Equal(t, field.ErrorTypeTooLong, errs[3].Type)
+}
+
+func TestValidateAutoscaler(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.Autoscaler = &v1beta1.AutoscalerConfig{
+		MinParallelism: 2,
+		MaxParallelism: 16,
+		Metrics:        []v1beta1.AutoscalerMetric{v1beta1.AutoscalerMetricBusyTime},
+	}
+	assert.Empty(t, ValidateApplication(&app))
+
+	target := 1.5
+	app.Spec.Parallelism = 32
+	app.Spec.Autoscaler.TargetUtilization = &target
+	app.Spec.Autoscaler.Metrics = []v1beta1.AutoscalerMetric{"Latency", v1beta1.AutoscalerMetricKafkaLag}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 4, len(errs))
+	assert.Equal(t, "spec.parallelism", errs[0].Field)
+	assert.Equal(t, "spec.autoscaler.targetUtilization", errs[1].Field)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[2].Type)
+	assert.Equal(t, "spec.autoscaler.metrics[0]", errs[2].Field)
+	assert.Equal(t, field.ErrorTypeRequired, errs[3].Type)
+	assert.Equal(t, "spec.autoscaler.maxKafkaLag", errs[3].Field)
+}
+
+func TestValidateAutoscalerBounds(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	app.Spec.Autoscaler = &v1beta1.AutoscalerConfig{
+		MinParallelism: 0,
+		MaxParallelism: -1,
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 4, len(errs))
+	assert.Equal(t, "spec.autoscaler.minParallelism", errs[0].Field)
+	assert.Equal(t, "spec.autoscaler.maxParallelism", errs[1].Field)
+	assert.Equal(t, "spec.parallelism", errs[2].Field)
+	assert.Equal(t, field.ErrorTypeForbidden, errs[3].Type)
+	assert.Equal(t, "spec.autoscaler", errs[3].Field)
+}
+
+func TestValidateSavepointSchedule(t *testing.T) {
+	app := getFlinkTestApp()
+	count := int32(0)
+	app.Spec.SavepointSchedule = &v1beta1.SavepointSchedule{
+		Interval:    &metav1.Duration{Duration: 10 * time.Second},
+		RetainCount: &count,
+		RetainFor:   &metav1.Duration{Duration: -time.Hour},
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, "spec.savepointSchedule.interval", errs[0].Field)
+	assert.Equal(t, "spec.savepointSchedule.retainCount", errs[1].Field)
+	assert.Equal(t, "spec.savepointSchedule.retainFor", errs[2].Field)
+
+	app.Spec.SavepointSchedule = &v1beta1.SavepointSchedule{}
+	errs = ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+	assert.Equal(t, "spec.savepointSchedule.interval", errs[0].Field)
+
+	app.Spec.SavepointSchedule.Interval = &metav1.Duration{Duration: 6 * time.Hour}
+	assert.Empty(t, ValidateApplication(&app))
+
+	// expired savepoints can only be deleted from a file system the operator supports
+	app.Spec.SavepointSchedule.DeleteExpired = true
+	errs = ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "spec.savepointSchedule.deleteExpired", errs[0].Field)
+
+	app.Spec.FlinkConfig = v1beta1.FlinkConfig{SavepointDirKey: "s3://bucket/savepoints"}
+	errs = ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "spec.savepointSchedule.deleteExpired", errs[0].Field)
+
+	app.Spec.FlinkConfig[SavepointDirKey] = "file:///checkpoints/flink/savepoints"
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateRestoreFrom(t *testing.T) {
+	app := getFlinkTestApp()
+	index := int32(-1)
+	app.Spec.RestoreFrom = &v1beta1.RestoreFrom{Index: &index}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "spec.restoreFrom.index", errs[0].Field)
+
+	app.Spec.RestoreFrom.Hash = "abcd1234"
+	errs = ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+
+	app.Spec.RestoreFrom = &v1beta1.RestoreFrom{}
+	errs = ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+
+	app.Spec.RestoreFrom.Hash = "abcd1234"
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidatePromotionPolicy(t *testing.T) {
+	app := getFlinkTestApp()
+	restarts := int32(-1)
+	app.Spec.BlueGreen = &v1beta1.BlueGreenConfig{
+		PromotionPolicy: &v1beta1.PromotionPolicy{
+			SoakDuration:   &metav1.Duration{},
+			MaxJobRestarts: &restarts,
+		},
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.blueGreen.promotionPolicy.soakDuration", errs[1].Field)
+	assert.Equal(t, "spec.blueGreen.promotionPolicy.maxJobRestarts", errs[2].Field)
+
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	app.Spec.BlueGreen.PromotionPolicy = &v1beta1.PromotionPolicy{}
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateAutoRollback(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	failures := int32(-1)
+	app.Spec.AutoRollback = &v1beta1.AutoRollbackPolicy{
+		Window:                          &metav1.Duration{Duration: -time.Minute},
+		MaxConsecutiveFailedCheckpoints: &failures,
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.autoRollback.window", errs[1].Field)
+	assert.Equal(t, "spec.autoRollback.maxConsecutiveFailedCheckpoints", errs[2].Field)
+
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeDual
+	app.Spec.AutoRollback = &v1beta1.AutoRollbackPolicy{}
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateBoundedJob(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.BoundedJob = &v1beta1.BoundedJobConfig{
+		TTLAfterFinished: &metav1.Duration{Duration: -time.Minute},
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "spec.boundedJob.ttlAfterFinished", errs[0].Field)
+
+	// the cluster may be deleted as soon as the job has finished
+	app.Spec.BoundedJob.TTLAfterFinished = &metav1.Duration{}
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateSessionJobs(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	app.Spec.Jobs = []v1beta1.SessionJob{
+		{Name: "enrichment", JarName: "enrichment.jar", Parallelism: 2},
+		{Name: "enrichment", JarName: "enrichment.jar", Parallelism: 2},
+		{Name: "", Parallelism: 0},
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 5, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, field.ErrorTypeDuplicate, errs[1].Type)
+	assert.Equal(t, "spec.jobs[1].name", errs[1].Field)
+	assert.Equal(t, "spec.jobs[2].name", errs[2].Field)
+	assert.Equal(t, "spec.jobs[2].jarName", errs[3].Field)
+	assert.Equal(t, "spec.jobs[2].parallelism", errs[4].Field)
+
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeDual
+	app.Spec.Jobs = app.Spec.Jobs[:1]
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateJarURI(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.JarChecksum = "abcd"
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.jarChecksum", errs[0].Field)
+
+	app.Spec.JarURI = "ftp://artifacts/job.jar"
+	app.Spec.ExecutionMode = v1beta1.ExecutionModeApplication
+	errs = ValidateApplication(&app)
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[1].Type)
+	assert.Equal(t, "spec.jarURI", errs[1].Field)
+	assert.Equal(t, "spec.jarChecksum", errs[2].Field)
+
+	app.Spec.JarURI = "s3://artifacts/job.jar"
+	app.Spec.JarChecksum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	app.Spec.ExecutionMode = v1beta1.ExecutionModeSession
+	errs = ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+	assert.Equal(t, "spec.jarURI", errs[0].Field)
+
+	assert.Nil(t, config.ConfigSection.SetConfig(&config.Config{
+		JarURIAllowlist: []string{"s3://artifacts"},
+	}))
+	defer func() {
+		_ = config.ConfigSection.SetConfig(&config.Config{})
+	}()
+	assert.Empty(t, ValidateApplication(&app))
+
+	app.Spec.JarURI = "file:///etc/passwd"
+	errs = ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+}
+
+func TestValidateComponentVolumes(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.Volumes = []coreV1.Volume{{Name: "config"}}
+	app.Spec.TaskManagerConfig.Volumes = []coreV1.Volume{{Name: "rocksdb"}, {Name: "rocksdb"}}
+	app.Spec.TaskManagerConfig.VolumeMounts = []coreV1.VolumeMount{
+		{Name: "rocksdb", MountPath: "/rocksdb"},
+		{Name: "config", MountPath: "/rocksdb"},
+		{Name: "missing", MountPath: "/missing"},
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, field.ErrorTypeDuplicate, errs[0].Type)
+	assert.Equal(t, "spec.taskManagerConfig.volumes[1].name", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeDuplicate, errs[1].Type)
+	assert.Equal(t, "spec.taskManagerConfig.volumeMounts[1].mountPath", errs[1].Field)
+	assert.Equal(t, field.ErrorTypeNotFound, errs[2].Type)
+	assert.Equal(t, "spec.taskManagerConfig.volumeMounts[2].name", errs[2].Field)
+
+	app.Spec.TaskManagerConfig.Volumes = app.Spec.TaskManagerConfig.Volumes[:1]
+	app.Spec.TaskManagerConfig.VolumeMounts = app.Spec.TaskManagerConfig.VolumeMounts[:1]
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateStatefulSetClaims(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.TaskManagerConfig.Volumes = []coreV1.Volume{{Name: "local-state"}}
+	app.Spec.TaskManagerConfig.StatefulSet = &v1beta1.TaskManagerStatefulSetConfig{
+		VolumeClaimTemplates: []coreV1.PersistentVolumeClaim{
+			{ObjectMeta: metav1.ObjectMeta{Name: "local-state"}},
+			{},
+		},
+	}
+	app.Spec.TaskManagerConfig.VolumeMounts = []coreV1.VolumeMount{{Name: "local-state", MountPath: "/state"}}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 2, len(errs))
+	assert.Equal(t, field.ErrorTypeDuplicate, errs[0].Type)
+	assert.Equal(t, "spec.taskManagerConfig.statefulSet.volumeClaimTemplates[0].metadata.name", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeRequired, errs[1].Type)
+	assert.Equal(t, "spec.taskManagerConfig.statefulSet.volumeClaimTemplates[1].metadata.name", errs[1].Field)
+
+	app.Spec.TaskManagerConfig.Volumes = nil
+	app.Spec.TaskManagerConfig.StatefulSet.VolumeClaimTemplates = app.Spec.TaskManagerConfig.StatefulSet.VolumeClaimTemplates[:1]
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidatePodDisruptionBudget(t *testing.T) {
+	app := getFlinkTestApp()
+	maxUnavailable := intstr.FromString("150%")
+	app.Spec.PodDisruptionBudget = &v1beta1.DisruptionBudgetConfig{MaxUnavailable: &maxUnavailable}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+	assert.Equal(t, "spec.podDisruptionBudget.maxUnavailable", errs[0].Field)
+
+	maxUnavailable = intstr.FromString("two")
+	assert.Equal(t, 1, len(ValidateApplication(&app)))
+
+	maxUnavailable = intstr.FromInt(-1)
+	assert.Equal(t, 1, len(ValidateApplication(&app)))
+
+	maxUnavailable = intstr.FromString("25%")
+	assert.Empty(t, ValidateApplication(&app))
+
+	maxUnavailable = intstr.FromInt(2)
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateSidecars(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.JobManagerConfig.Sidecars = []coreV1.Container{
+		{Name: getFlinkContainerName(JobManagerContainerName), Image: "sidecar"},
+		{Name: "log-shipper"},
+	}
+	app.Spec.JobManagerConfig.InitContainers = []coreV1.Container{
+		{Name: "log-shipper", Image: "init"},
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 3, len(errs))
+	assert.Equal(t, field.ErrorTypeDuplicate, errs[0].Type)
+	assert.Equal(t, "spec.jobManagerConfig.sidecars[0].name", errs[0].Field)
+	assert.Equal(t, field.ErrorTypeRequired, errs[1].Type)
+	assert.Equal(t, "spec.jobManagerConfig.sidecars[1].image", errs[1].Field)
+	assert.Equal(t, field.ErrorTypeDuplicate, errs[2].Type)
+	assert.Equal(t, "spec.jobManagerConfig.initContainers[0].name", errs[2].Field)
+
+	app.Spec.JobManagerConfig.Sidecars[0].Name = "metrics"
+	app.Spec.JobManagerConfig.Sidecars[1].Image = "fluent-bit"
+	app.Spec.JobManagerConfig.InitContainers[0].Name = "fetch-secrets"
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidatePodTemplate(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.TaskManagerConfig.PodTemplate = &coreV1.PodTemplateSpec{
+		Spec: coreV1.PodSpec{
+			Containers: []coreV1.Container{{Image: "sidecar"}},
+		},
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeRequired, errs[0].Type)
+	assert.Equal(t, "spec.taskManagerConfig.podTemplate.spec.containers[0].name", errs[0].Field)
+
+	app.Spec.TaskManagerConfig.PodTemplate.Spec.Containers[0].Name = "sidecar"
+	assert.Empty(t, ValidateApplication(&app))
+}
+
+func TestValidateFlinkConfig(t *testing.T) {
+	app := getFlinkTestApp()
+	app.Spec.FlinkConfig = v1beta1.FlinkConfig{
+		"akka.timeout": map[string]interface{}{"value": "5s"},
+	}
+
+	errs := ValidateApplication(&app)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, "spec.flinkConfig", errs[0].Field)
+}
+
+func TestValidateDeploymentModeChange(t *testing.T) {
+	old := getFlinkTestApp()
+	old.Spec.DeploymentMode = v1beta1.DeploymentModeDual
+	old.Status.DeploymentMode = v1beta1.DeploymentModeDual
+
+	app := getFlinkTestApp()
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+
+	errs := ValidateApplicationUpdate(&app, &old)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+
+	// an unset mode is the same as Dual
+	old.Status.DeploymentMode = ""
+	old.Spec.DeploymentMode = ""
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeDual
+	assert.Empty(t, ValidateApplicationUpdate(&app, &old))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/lyft/flytestdlib/logger"
 	v1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
+	policyV1beta1 "k8s.io/api/policy/v1beta1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -131,9 +132,14 @@ func Add(ctx context.Context, mgr manager.Manager, cfg config.RuntimeConfig) err
 		flinkStateMachine: flinkStateMachine,
 	}
 
+	var r reconcile.Reconciler = &reconciler
+	if cfg.Health != nil {
+		r = cfg.Health.TrackReconciler(r)
+	}
+
 	c, err := controller.New(config.AppName, mgr, controller.Options{
 		MaxConcurrentReconciles: config.GetConfig().Workers,
-		Reconciler:              &reconciler,
+		Reconciler:              r,
 	})
 
 	if err != nil {
@@ -144,14 +150,22 @@ func Add(ctx context.Context, mgr manager.Manager, cfg config.RuntimeConfig) err
 		return err
 	}
 
-	// Watch deployments and services for the application
+	// Watch deployments, statefulsets, services and pod disruption budgets for the application
 	if err := c.Watch(&source.Kind{Type: &v1.Deployment{}}, &handler.Funcs{}, getPredicateFuncs()); err != nil {
 		return err
 	}
 
+	if err := c.Watch(&source.Kind{Type: &v1.StatefulSet{}}, &handler.Funcs{}, getPredicateFuncs()); err != nil {
+		return err
+	}
+
 	if err := c.Watch(&source.Kind{Type: &coreV1.Service{}}, &handler.Funcs{}, getPredicateFuncs()); err != nil {
 		return err
 	}
+
+	if err := c.Watch(&source.Kind{Type: &policyV1beta1.PodDisruptionBudget{}}, &handler.Funcs{}, getPredicateFuncs()); err != nil {
+		return err
+	}
 	return nil
 }
 
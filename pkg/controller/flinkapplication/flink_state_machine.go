@@ -3,6 +3,7 @@ package flinkapplication
 import (
 	"context"
 	"math"
+	"sort"
 	"time"
 
 	"k8s.io/client-go/tools/record"
@@ -16,10 +17,12 @@ import (
 	"github.com/lyft/flinkk8soperator/pkg/controller/flink"
 	"github.com/lyft/flinkk8soperator/pkg/controller/flink/client"
 	"github.com/lyft/flinkk8soperator/pkg/controller/k8"
+	"github.com/lyft/flinkk8soperator/pkg/controller/savepoint"
 	"github.com/lyft/flytestdlib/logger"
 	"github.com/lyft/flytestdlib/promutils"
 	"github.com/lyft/flytestdlib/promutils/labeled"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
 )
@@ -30,6 +33,12 @@ const (
 	statusUnchanged = false
 )
 
+// The number of autoscaler decisions kept in the status of the application
+const maxAutoscalerHistory = 10
+
+// The number of savepoints kept in the status of the application
+const maxSavepointHistory = 10
+
 // The core state machine that manages Flink clusters and jobs. See docs/state_machine.md for a description of the
 // states and transitions.
 type FlinkHandlerInterface interface {
@@ -37,11 +46,12 @@ type FlinkHandlerInterface interface {
 }
 
 type FlinkStateMachine struct {
-	flinkController flink.ControllerInterface
-	k8Cluster       k8.ClusterInterface
-	clock           clock.Clock
-	metrics         *stateMachineMetrics
-	retryHandler    client.RetryHandlerInterface
+	flinkController  flink.ControllerInterface
+	k8Cluster        k8.ClusterInterface
+	clock            clock.Clock
+	metrics          *stateMachineMetrics
+	retryHandler     client.RetryHandlerInterface
+	savepointStorage savepoint.Storage
 }
 
 type stateMachineMetrics struct {
@@ -158,6 +168,7 @@ func (s *FlinkStateMachine) handle(ctx context.Context, application *v1beta1.Fli
 
 	if !application.ObjectMeta.DeletionTimestamp.IsZero() && appPhase != v1beta1.FlinkApplicationDeleting {
 		s.updateApplicationPhase(application, v1beta1.FlinkApplicationDeleting)
+		s.updateConditions(ctx, application)
 		// Always perform a single application update per callback
 		return statusChanged, nil
 	}
@@ -188,6 +199,12 @@ func (s *FlinkStateMachine) handle(ctx context.Context, application *v1beta1.Fli
 			updateApplication, appErr = s.handleApplicationDeleting(ctx, application)
 		case v1beta1.FlinkApplicationDualRunning:
 			updateApplication, appErr = s.handleDualRunning(ctx, application)
+		case v1beta1.FlinkApplicationSuspending:
+			updateApplication, appErr = s.handleApplicationSuspending(ctx, application)
+		case v1beta1.FlinkApplicationSuspended:
+			updateApplication, appErr = s.handleApplicationSuspended(ctx, application)
+		case v1beta1.FlinkApplicationSucceeded, v1beta1.FlinkApplicationFailed:
+			updateApplication, appErr = s.handleApplicationFinished(ctx, application)
 
 		}
 
@@ -199,9 +216,67 @@ func (s *FlinkStateMachine) handle(ctx context.Context, application *v1beta1.Fli
 	} else {
 		logger.Infof(ctx, "Handle state skipped for application, lastSeenError %v", application.Status.LastSeenError)
 	}
+
+	if updateApplication || updateLastSeenError {
+		s.updateConditions(ctx, application)
+	}
 	return updateApplication || updateLastSeenError, appErr
 }
 
+// Derives the phase-dependent conditions from the current status. The JobRunning condition is maintained by
+// CompareAndUpdateJobStatus while a job exists, and the SavepointSucceeded condition by the Savepointing phase.
+func (s *FlinkStateMachine) updateConditions(ctx context.Context, application *v1beta1.FlinkApplication) {
+	phase := application.Status.Phase
+	status := &application.Status
+
+	switch phase {
+	case v1beta1.FlinkApplicationRunning, v1beta1.FlinkApplicationDeployFailed, v1beta1.FlinkApplicationSuspended,
+		v1beta1.FlinkApplicationSucceeded, v1beta1.FlinkApplicationFailed:
+		status.SetCondition(v1beta1.ConditionProgressing, corev1.ConditionFalse, phase.VerboseString(), "")
+	default:
+		status.SetCondition(v1beta1.ConditionProgressing, corev1.ConditionTrue, phase.VerboseString(), "")
+	}
+
+	switch {
+	case phase == v1beta1.FlinkApplicationDeployFailed:
+		status.SetCondition(v1beta1.ConditionDegraded, corev1.ConditionTrue, "DeployFailed",
+			"The deploy failed and the application was rolled back")
+	case phase == v1beta1.FlinkApplicationRollingBackJob:
+		status.SetCondition(v1beta1.ConditionDegraded, corev1.ConditionTrue, "RollingBack",
+			"The application is being rolled back")
+	case phase == v1beta1.FlinkApplicationFailed:
+		status.SetCondition(v1beta1.ConditionDegraded, corev1.ConditionTrue, "JobFailed",
+			"The bounded job has failed")
+	case status.LastSeenError != nil:
+		status.SetCondition(v1beta1.ConditionDegraded, corev1.ConditionTrue, "Error", status.LastSeenError.AppError)
+	default:
+		status.SetCondition(v1beta1.ConditionDegraded, corev1.ConditionFalse, "AsExpected", "")
+	}
+
+	switch {
+	case phase == v1beta1.FlinkApplicationNew || phase == v1beta1.FlinkApplicationUpdating ||
+		phase == v1beta1.FlinkApplicationClusterStarting:
+		status.SetCondition(v1beta1.ConditionClusterReady, corev1.ConditionFalse, "ClusterStarting",
+			"The cluster for the current version is not yet available")
+	case phase == v1beta1.FlinkApplicationSuspended:
+		status.SetCondition(v1beta1.ConditionClusterReady, corev1.ConditionFalse, "Suspended",
+			"The cluster has been deleted while the application is suspended")
+	case status.FinishedJob != nil && status.FinishedJob.ClusterDeleted:
+		status.SetCondition(v1beta1.ConditionClusterReady, corev1.ConditionFalse, "ClusterDeleted",
+			"The cluster has been deleted after the bounded job finished")
+	case s.flinkController.GetLatestClusterStatus(ctx, application).Health == v1beta1.Red:
+		status.SetCondition(v1beta1.ConditionClusterReady, corev1.ConditionFalse, "ClusterUnhealthy",
+			"The cluster for the current version is unhealthy")
+	default:
+		status.SetCondition(v1beta1.ConditionClusterReady, corev1.ConditionTrue, "ClusterReady", "")
+	}
+
+	if s.flinkController.GetLatestJobID(ctx, application) == "" {
+		status.SetCondition(v1beta1.ConditionJobRunning, corev1.ConditionFalse, "NoJob",
+			"No job is currently submitted")
+	}
+}
+
 func (s *FlinkStateMachine) IsTimeToHandlePhase(application *v1beta1.FlinkApplication, phase v1beta1.FlinkApplicationPhase) bool {
 	if phase == v1beta1.FlinkApplicationDeleting {
 		// reset lastSeenError and retryCount in case the application was failing in its previous phase
@@ -233,12 +308,38 @@ func (s *FlinkStateMachine) IsTimeToHandlePhase(application *v1beta1.FlinkApplic
 
 // In this state we create a new cluster, either due to an entirely new FlinkApplication or due to an update.
 func (s *FlinkStateMachine) handleNewOrUpdating(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
-	// TODO: add up-front validation on the FlinkApplication resource
+	// Up-front validation of the FlinkApplication resource is performed by the validating webhook (pkg/webhook)
+	if isSuspendRequested(application) && application.Status.DeployHash == "" {
+		// nothing is running yet, so the cluster is only created once the application is resumed
+		s.updateApplicationPhase(application, v1beta1.FlinkApplicationSuspended)
+		return statusChanged, nil
+	}
+	// The webhook rejects this combination, but it is optional. A BlueGreen update keeps the job of the old version
+	// running, which in application mode we would keep trying to stop before creating the new cluster.
+	if v1beta1.IsApplicationExecutionMode(application.Spec.ExecutionMode) &&
+		v1beta1.IsBlueGreenDeploymentMode(application.Spec.DeploymentMode) {
+		s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "UnsupportedDeploymentMode",
+			"The Application execution mode is not supported with the BlueGreen deployment mode")
+		return s.deployFailed(ctx, application)
+	}
 	if rollback, reason := s.shouldRollback(ctx, application); rollback {
 		// we've failed to make progress; move to deploy failed
 		s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "ClusterCreationFailed",
 			fmt.Sprintf("Failed to create Flink Cluster: %s", reason))
-		return s.deployFailed(application)
+		return s.deployFailed(ctx, application)
+	}
+	if !s.resolveRestoreFrom(ctx, application) {
+		return s.deployFailed(ctx, application)
+	}
+	if flink.HasStaleAutoscaledParallelism(application) {
+		// spec.parallelism has been changed, which replaces the parallelism chosen by the autoscaler
+		flink.SetAutoscaledParallelism(application, 0)
+		if err := s.k8Cluster.UpdateK8Object(ctx, application); err != nil {
+			return statusUnchanged, err
+		}
+	}
+	if status := application.Status.Autoscaler; status != nil && status.SpecParallelism != application.Spec.Parallelism {
+		status.Parallelism = 0
 	}
 	// Update version if blue/green deploy
 	if v1beta1.IsBlueGreenDeploymentMode(application.Status.DeploymentMode) {
@@ -250,6 +351,24 @@ func (s *FlinkStateMachine) handleNewOrUpdating(ctx context.Context, application
 		// Reset teardown hash if set
 		application.Status.TeardownHash = ""
 	}
+
+	if v1beta1.IsApplicationExecutionMode(application.Spec.ExecutionMode) {
+		// In application mode the job is started together with the cluster, so the previous job needs to be
+		// stopped first in order to know the savepoint to start from
+		if application.Status.DeployHash != "" && s.flinkController.GetLatestJobID(ctx, application) != "" {
+			if application.Spec.SavepointDisabled {
+				s.updateApplicationPhase(application, v1beta1.FlinkApplicationCancelling)
+			} else {
+				s.updateApplicationPhase(application, v1beta1.FlinkApplicationSavepointing)
+			}
+			return statusChanged, nil
+		}
+
+		if err := s.addFinalizerIfMissing(ctx, application, jobFinalizer); err != nil {
+			return statusUnchanged, err
+		}
+	}
+
 	// Create the Flink cluster
 	err := s.flinkController.CreateCluster(ctx, application)
 	if err != nil {
@@ -261,7 +380,34 @@ func (s *FlinkStateMachine) handleNewOrUpdating(ctx context.Context, application
 	return statusChanged, nil
 }
 
-func (s *FlinkStateMachine) deployFailed(app *v1beta1.FlinkApplication) (bool, error) {
+// Resolves spec.restoreFrom to a savepoint of the history when a deploy starts, and records it in the status so that
+// savepoints taken during the deploy do not change which one is used, and so that later deploys do not apply it
+// again once a job has been started from it. Returns false if the history has no matching savepoint.
+func (s *FlinkStateMachine) resolveRestoreFrom(ctx context.Context, app *v1beta1.FlinkApplication) bool {
+	if app.Spec.RestoreFrom == nil {
+		app.Status.RestoreFrom = nil
+		return true
+	}
+	if flink.IsRestoreFromResolved(app) {
+		return true
+	}
+
+	path, err := flink.GetRestoreFromPath(app)
+	if err != nil {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "RestoreFromFailed",
+			fmt.Sprintf("Cannot restore from the savepoint history: %v", err))
+		return false
+	}
+	restoreFrom := app.Spec.RestoreFrom.DeepCopy()
+	app.Status.RestoreFrom = &v1beta1.RestoreFromStatus{
+		Index: restoreFrom.Index,
+		Hash:  restoreFrom.Hash,
+		Path:  path,
+	}
+	return true
+}
+
+func (s *FlinkStateMachine) deployFailed(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
 	hash := flink.HashForApplication(app)
 	app.Status.FailedDeployHash = hash
 	// set rollbackHash to deployHash
@@ -269,11 +415,35 @@ func (s *FlinkStateMachine) deployFailed(app *v1beta1.FlinkApplication) (bool, e
 	// Reset error and retry count
 	app.Status.LastSeenError = nil
 	app.Status.RetryCount = 0
+	s.revertAutoscaling(ctx, app)
 
 	s.updateApplicationPhase(app, v1beta1.FlinkApplicationDeployFailed)
 	return statusChanged, nil
 }
 
+// If the failed deploy was a rescale by the autoscaler, its decision is reverted so that the application matches the
+// version that is still deployed again. This lets the autoscaler continue from DeployFailed once the job is running.
+func (s *FlinkStateMachine) revertAutoscaling(ctx context.Context, app *v1beta1.FlinkApplication) {
+	status := app.Status.Autoscaler
+	if status == nil || status.Parallelism == 0 || len(status.History) == 0 || app.Status.DeployHash == "" ||
+		flink.HashForApplication(app) == app.Status.DeployHash {
+		return
+	}
+
+	reverted := app.DeepCopy()
+	parallelism := status.History[len(status.History)-1].FromParallelism
+	flink.SetAutoscaledParallelism(reverted, parallelism)
+	if flink.HashForApplication(reverted) != app.Status.DeployHash {
+		return
+	}
+	if err := s.k8Cluster.UpdateK8Object(ctx, reverted); err != nil {
+		logger.Warnf(ctx, "Failed to revert the autoscaled parallelism: %v", err)
+		return
+	}
+	app.ObjectMeta = reverted.ObjectMeta
+	status.Parallelism = parallelism
+}
+
 // Create the underlying Kubernetes objects for the new cluster
 func (s *FlinkStateMachine) handleClusterStarting(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
 	if rollback, reason := s.shouldRollback(ctx, application); rollback {
@@ -282,7 +452,23 @@ func (s *FlinkStateMachine) handleClusterStarting(ctx context.Context, applicati
 		s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "ClusterCreationFailed",
 			fmt.Sprintf(
 				"Flink cluster failed to become available: %s", reason))
-		return s.deployFailed(application)
+		if v1beta1.IsApplicationExecutionMode(application.Spec.ExecutionMode) {
+			// the job (if any) belongs to the failed cluster, and must not run next to the restored one
+			if jobID := s.flinkController.GetLatestJobID(ctx, application); jobID != "" {
+				err := s.flinkController.ForceCancel(ctx, application, flink.HashForApplication(application), jobID)
+				if err != nil {
+					logger.Warnf(ctx, "Failed to cancel job %s of the failed cluster: %v", jobID, err)
+				}
+			}
+			s.flinkController.UpdateLatestJobID(ctx, application, "")
+			if application.Status.DeployHash != "" {
+				// the previous job has been stopped to start the new cluster, so it is started again
+				application.Status.RetryCount = 0
+				s.updateApplicationPhase(application, v1beta1.FlinkApplicationRollingBackJob)
+				return statusChanged, nil
+			}
+		}
+		return s.deployFailed(ctx, application)
 	}
 
 	// Wait for all to be running
@@ -297,6 +483,10 @@ func (s *FlinkStateMachine) handleClusterStarting(ctx context.Context, applicati
 		return statusUnchanged, nil
 	}
 
+	if v1beta1.IsApplicationExecutionMode(application.Spec.ExecutionMode) {
+		return s.handleApplicationModeJobStarting(ctx, application)
+	}
+
 	if v1beta1.IsBlueGreenDeploymentMode(application.Status.DeploymentMode) {
 		// Update hashes
 		s.flinkController.UpdateLatestVersionAndHash(application, application.Status.UpdatingVersion, flink.HashForApplication(application))
@@ -331,17 +521,23 @@ func (s *FlinkStateMachine) initializeAppStatusIfEmpty(application *v1beta1.Flin
 func (s *FlinkStateMachine) handleApplicationSavepointing(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
 	// we've already savepointed (or this is our first deploy), continue on
 	if application.Status.SavepointPath != "" || application.Status.DeployHash == "" {
-		s.updateApplicationPhase(application, v1beta1.FlinkApplicationSubmittingJob)
+		s.updateApplicationPhase(application, getPhaseAfterJobStopped(application))
 		return statusChanged, nil
 	}
 
 	if rollback, reason := s.shouldRollback(ctx, application); rollback {
 		s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "SavepointFailed",
 			fmt.Sprintf("Could not savepoint existing job: %s", reason))
+		application.Status.SetCondition(v1beta1.ConditionSavepointSucceeded, corev1.ConditionFalse, "SavepointFailed", reason)
 		application.Status.RetryCount = 0
 		s.updateApplicationPhase(application, v1beta1.FlinkApplicationRecovering)
 		return statusChanged, nil
 	}
+	// the jobs in spec.jobs are stopped first, and are submitted again from their savepoints once the new cluster runs
+	if stopped, changed := s.stopSessionJobs(ctx, application); !stopped {
+		return changed, nil
+	}
+
 	cancelFlag := getCancelFlag(application)
 	// we haven't started savepointing yet; do so now
 	// TODO: figure out the idempotence of this
@@ -376,6 +572,8 @@ func (s *FlinkStateMachine) handleApplicationSavepointing(ctx context.Context, a
 		s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "SavepointFailed",
 			fmt.Sprintf("Failed to take savepoint for job %s: %v",
 				s.flinkController.GetLatestJobID(ctx, application), savepointStatusResponse.Operation.FailureCause))
+		application.Status.SetCondition(v1beta1.ConditionSavepointSucceeded, corev1.ConditionFalse, "SavepointFailed",
+			savepointStatusResponse.Operation.FailureCause.Class)
 		application.Status.RetryCount = 0
 		s.updateApplicationPhase(application, v1beta1.FlinkApplicationRecovering)
 		return statusChanged, nil
@@ -391,17 +589,30 @@ func (s *FlinkStateMachine) handleApplicationSavepointing(ctx context.Context, a
 		}
 
 		application.Status.SavepointPath = savepointStatusResponse.Operation.Location
+		application.Status.SetCondition(v1beta1.ConditionSavepointSucceeded, corev1.ConditionTrue, "SavepointCompleted",
+			savepointStatusResponse.Operation.Location)
+		s.recordSavepoint(application, savepointStatusResponse.Operation.Location, v1beta1.SavepointReasonUpdate,
+			s.flinkController.GetLatestJobID(ctx, application), application.Status.DeployHash)
 		// We haven't cancelled the job in this case, so don't reset job ID
 		if !v1beta1.IsBlueGreenDeploymentMode(application.Status.DeploymentMode) {
 			s.flinkController.UpdateLatestJobID(ctx, application, "")
 		}
-		s.updateApplicationPhase(application, v1beta1.FlinkApplicationSubmittingJob)
+		s.updateApplicationPhase(application, getPhaseAfterJobStopped(application))
 		return statusChanged, nil
 	}
 
 	return statusUnchanged, nil
 }
 
+// Once the previous job has been stopped, the new job is submitted to the new cluster. In application mode the new
+// cluster runs the job itself, and is only created at this point.
+func getPhaseAfterJobStopped(app *v1beta1.FlinkApplication) v1beta1.FlinkApplicationPhase {
+	if v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) {
+		return v1beta1.FlinkApplicationUpdating
+	}
+	return v1beta1.FlinkApplicationSubmittingJob
+}
+
 func (s *FlinkStateMachine) handleApplicationCancelling(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
 
 	// this is the first deploy
@@ -433,7 +644,7 @@ func (s *FlinkStateMachine) handleApplicationCancelling(ctx context.Context, app
 	}
 
 	application.Status.JobStatus.JobID = ""
-	s.updateApplicationPhase(application, v1beta1.FlinkApplicationSubmittingJob)
+	s.updateApplicationPhase(application, getPhaseAfterJobStopped(application))
 	return statusChanged, nil
 }
 
@@ -457,13 +668,13 @@ func (s *FlinkStateMachine) handleApplicationRecovering(ctx context.Context, app
 		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "RecoveryFailed",
 			"Failed to get externalized checkpoint config, could not recover. "+
 				"Manual intervention is needed.")
-		return s.deployFailed(app)
+		return s.deployFailed(ctx, app)
 	} else if path == "" {
 		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "RecoveryFailed",
 			"No externalized checkpoint found, could not recover. Make sure that "+
 				"externalized checkpoints are enabled in your job's checkpoint configuration. Manual intervention "+
 				"is needed to recover.")
-		return s.deployFailed(app)
+		return s.deployFailed(ctx, app)
 	}
 
 	s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "RestoringExternalizedCheckpoint",
@@ -471,8 +682,10 @@ func (s *FlinkStateMachine) handleApplicationRecovering(ctx context.Context, app
 			path, flink.HashForApplication(app)))
 
 	app.Status.SavepointPath = path
+	s.recordSavepoint(app, path, v1beta1.SavepointReasonRecovery, s.flinkController.GetLatestJobID(ctx, app),
+		app.Status.DeployHash)
 	s.flinkController.UpdateLatestJobID(ctx, app, "")
-	s.updateApplicationPhase(app, v1beta1.FlinkApplicationSubmittingJob)
+	s.updateApplicationPhase(app, getPhaseAfterJobStopped(app))
 	return statusChanged, nil
 }
 
@@ -572,23 +785,26 @@ func (s *FlinkStateMachine) handleSubmittingJob(ctx context.Context, app *v1beta
 	}
 
 	if s.flinkController.GetLatestJobID(ctx, app) == "" {
-		savepointPath := ""
-		if app.Status.DeployHash == "" {
-			// this is the first deploy, use the user-provided savepoint
-			savepointPath = app.Spec.SavepointPath
-			if savepointPath == "" {
-				//nolint // fall back to the old config for backwards-compatibility
-				savepointPath = app.Spec.SavepointInfo.SavepointLocation
+		jarName := app.Spec.JarName
+		if app.Spec.JarURI != "" {
+			if app.Status.JarID == "" || app.Status.JarHash != hash {
+				uploaded, err := s.uploadJarIfNeeded(ctx, app, hash)
+				if err != nil || uploaded {
+					return uploaded, err
+				}
 			}
-		} else {
-			// otherwise use the savepoint created by the operator
-			savepointPath = app.Status.SavepointPath
+			jarName = app.Status.JarID
 		}
 
 		appJobID, err := s.submitJobIfNeeded(ctx, app, hash,
-			app.Spec.JarName, app.Spec.Parallelism, app.Spec.EntryClass, app.Spec.ProgramArgs,
-			app.Spec.AllowNonRestoredState, savepointPath)
+			jarName, flink.GetParallelism(app), app.Spec.EntryClass, app.Spec.ProgramArgs,
+			app.Spec.AllowNonRestoredState, flink.GetSavepointPathForDeploy(app))
 		if err != nil {
+			if app.Spec.JarURI != "" && app.Status.JarHash == hash {
+				// the uploaded jar does not survive a restart of the job manager, so it is uploaded again on the retry
+				app.Status.JarHash = ""
+				return statusChanged, err
+			}
 			return statusUnchanged, err
 		}
 
@@ -601,6 +817,66 @@ func (s *FlinkStateMachine) handleSubmittingJob(ctx context.Context, app *v1beta
 		return statusUnchanged, nil
 	}
 
+	return s.handleJobStarting(ctx, app, hash)
+}
+
+// Uploads the jar at spec.jarURI to the cluster of the given hash, unless a job is already active on it. The id the
+// cluster gives the jar is saved before the job is submitted with it, so that later reconciles do not upload it again.
+func (s *FlinkStateMachine) uploadJarIfNeeded(ctx context.Context, app *v1beta1.FlinkApplication, hash string) (bool, error) {
+	jobs, err := s.flinkController.GetJobsForApplication(ctx, app, hash)
+	if err != nil {
+		return statusUnchanged, err
+	}
+	if len(flink.GetActiveFlinkJobs(jobs)) > 0 {
+		// the job was submitted in a previous cycle, which is picked up without submitting it again
+		return statusUnchanged, nil
+	}
+
+	jarID, err := s.flinkController.UploadJar(ctx, app, hash, app.Spec.JarURI, app.Spec.JarChecksum)
+	if err != nil {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "JarUploadFailed",
+			fmt.Sprintf("Failed to upload jar %s to cluster for deploy %s: %v", app.Spec.JarURI, hash, err))
+		return statusUnchanged, err
+	}
+	app.Status.JarID = jarID
+	app.Status.JarHash = hash
+	return statusChanged, nil
+}
+
+// In application mode the job is started by the job manager itself, so instead of submitting it we wait for it to
+// show up on the new cluster
+func (s *FlinkStateMachine) handleApplicationModeJobStarting(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
+	hash := flink.HashForApplication(app)
+	err := s.updateGenericService(ctx, app, hash)
+	if err != nil {
+		return statusUnchanged, err
+	}
+
+	_, clusterErr := s.flinkController.CompareAndUpdateClusterStatus(ctx, app, hash)
+	if clusterErr != nil {
+		logger.Errorf(ctx, "Updating cluster status failed with error: %v", clusterErr)
+	}
+
+	if s.flinkController.GetLatestJobID(ctx, app) == "" {
+		jobs, err := s.flinkController.GetJobsForApplication(ctx, app, hash)
+		if err != nil {
+			return statusUnchanged, err
+		}
+		if len(jobs) == 0 {
+			return statusUnchanged, nil
+		}
+
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "JobStarted",
+			fmt.Sprintf("Job %s was started by the job manager", jobs[0].JobID))
+		s.flinkController.UpdateLatestJobID(ctx, app, jobs[0].JobID)
+		return statusChanged, nil
+	}
+
+	return s.handleJobStarting(ctx, app, hash)
+}
+
+// Waits for the job of the new cluster to be running, and then moves the application to a running phase
+func (s *FlinkStateMachine) handleJobStarting(ctx context.Context, app *v1beta1.FlinkApplication, hash string) (bool, error) {
 	// get the state of the current application
 	job, err := s.flinkController.GetJobForApplication(ctx, app, hash)
 	if err != nil {
@@ -616,11 +892,18 @@ func (s *FlinkStateMachine) handleSubmittingJob(ctx context.Context, app *v1beta
 		allVerticesStarted = allVerticesStarted && (v.StartTime > 0)
 	}
 
-	if job.State == client.Running && allVerticesStarted {
+	// a bounded job may already have finished by the time it is first observed
+	if (job.State == client.Running && allVerticesStarted) ||
+		(app.Spec.BoundedJob != nil && job.State == client.Finished) {
+		s.startAutoRollbackWindow(ctx, app, hash)
 		// Update job status
 		jobStatus := s.flinkController.GetLatestJobStatus(ctx, app)
 		jobStatus.JarName = app.Spec.JarName
-		jobStatus.Parallelism = app.Spec.Parallelism
+		if app.Spec.JarURI != "" {
+			// an uploaded jar is referred to by the id the cluster gave it, which a later rollback to this deploy needs
+			jobStatus.JarName = app.Status.JarID
+		}
+		jobStatus.Parallelism = flink.GetParallelism(app)
 		jobStatus.EntryClass = app.Spec.EntryClass
 		jobStatus.ProgramArgs = app.Spec.ProgramArgs
 		jobStatus.AllowNonRestoredState = app.Spec.AllowNonRestoredState
@@ -628,7 +911,11 @@ func (s *FlinkStateMachine) handleSubmittingJob(ctx context.Context, app *v1beta
 		// Update the application status with the running job info
 		app.Status.SavepointPath = ""
 		app.Status.SavepointTriggerID = ""
+		if app.Status.RestoreFrom != nil {
+			app.Status.RestoreFrom.Restored = true
+		}
 		if v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) && app.Status.DeployHash != "" {
+			// a bounded job that has already finished replaces the previous version from DualRunning
 			s.updateApplicationPhase(app, v1beta1.FlinkApplicationDualRunning)
 			return statusChanged, nil
 		}
@@ -640,6 +927,25 @@ func (s *FlinkStateMachine) handleSubmittingJob(ctx context.Context, app *v1beta
 	return statusUnchanged, nil
 }
 
+// Keeps what is needed to bring back the previous version, if spec.autoRollback is set and the new version replaces
+// one that was running
+func (s *FlinkStateMachine) startAutoRollbackWindow(ctx context.Context, app *v1beta1.FlinkApplication, hash string) {
+	if app.Spec.AutoRollback == nil || app.Status.DeployHash == "" || app.Status.DeployHash == hash ||
+		v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
+		app.Status.AutoRollback = nil
+		return
+	}
+
+	previousJob := s.flinkController.GetLatestJobStatus(ctx, app)
+	app.Status.AutoRollback = &v1beta1.AutoRollbackStatus{
+		Hash:          hash,
+		PreviousHash:  app.Status.DeployHash,
+		PreviousJob:   *previousJob.DeepCopy(),
+		SavepointPath: app.Status.SavepointPath,
+		DeployTime:    v1.NewTime(s.clock.Now()),
+	}
+}
+
 // Something has gone wrong during the update, post job-cancellation (and cluster tear-down in single mode). We need
 // to try to get things back into a working state
 func (s *FlinkStateMachine) handleRollingBack(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
@@ -648,7 +954,7 @@ func (s *FlinkStateMachine) handleRollingBack(ctx context.Context, app *v1beta1.
 		// move immediately to the DeployFailed state so that the user can recover.
 		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "RollbackFailed",
 			fmt.Sprintf("Failed to rollback to original deployment, manual intervention needed: %s", reason))
-		return s.deployFailed(app)
+		return s.deployFailed(ctx, app)
 	}
 
 	s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "DeployFailed",
@@ -657,7 +963,11 @@ func (s *FlinkStateMachine) handleRollingBack(ctx context.Context, app *v1beta1.
 	// In the case of blue green deploys, we don't try to submit a new job
 	// and instead transition to a deploy failed state
 	if v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) && app.Status.DeployHash != "" {
-		return s.deployFailed(app)
+		return s.deployFailed(ctx, app)
+	}
+	// Neither can a job be submitted to a cluster running in application mode
+	if v1beta1.IsApplicationExecutionMode(app.Spec.ExecutionMode) {
+		return s.rollBackApplicationCluster(ctx, app)
 	}
 	// TODO: handle single mode
 
@@ -698,15 +1008,76 @@ func (s *FlinkStateMachine) handleRollingBack(ctx context.Context, app *v1beta1.
 		// move to the deploy failed state
 		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "RollbackSucceeded",
 			"Successfully rolled back to previous deploy")
-		return s.deployFailed(app)
+		return s.deployFailed(ctx, app)
 	}
 
 	return statusUnchanged, nil
 }
 
+// In application mode the previous cluster is kept until the new one is running, but its job manager only runs the job
+// it was started with. If that job has been stopped, the job manager is restarted from the savepoint taken when
+// stopping it, which runs the job again.
+func (s *FlinkStateMachine) rollBackApplicationCluster(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
+	hash := app.Status.DeployHash
+	if hash == "" {
+		return s.deployFailed(ctx, app)
+	}
+
+	err := s.updateGenericService(ctx, app, hash)
+	if err != nil {
+		return statusUnchanged, err
+	}
+
+	isReady, _ := s.flinkController.IsServiceReady(ctx, app, hash)
+	// Ignore errors
+	if !isReady {
+		return statusUnchanged, nil
+	}
+
+	jobs, err := s.flinkController.GetJobsForApplication(ctx, app, hash)
+	if err != nil {
+		return statusUnchanged, err
+	}
+	for _, job := range jobs {
+		if job.Status == client.Finished || job.Status == client.Canceled || job.Status == client.Failed {
+			continue
+		}
+
+		// either the previous job has not been stopped, or the job manager has been restarted
+		s.flinkController.UpdateLatestJobID(ctx, app, job.JobID)
+		app.Status.SavepointPath = ""
+		app.Status.SavepointTriggerID = ""
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "RollbackSucceeded",
+			"Successfully rolled back to previous deploy")
+		return s.deployFailed(ctx, app)
+	}
+
+	if app.Status.SavepointPath == "" {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "RollbackFailed",
+			"The previous job was stopped without a savepoint, so it cannot be restored")
+		return s.deployFailed(ctx, app)
+	}
+
+	restarted, err := s.flinkController.RestartJobManagerFromSavepoint(ctx, app, hash, app.Status.SavepointPath)
+	if err != nil {
+		return statusUnchanged, err
+	}
+	if restarted {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "RollingBack",
+			fmt.Sprintf("Restarting the job manager of deploy %s from savepoint %s", hash, app.Status.SavepointPath))
+	}
+	return statusUnchanged, nil
+}
+
 // Check if the application is Running.
 // This is a stable state. Keep monitoring if the underlying CRD reflects the Flink cluster
 func (s *FlinkStateMachine) handleApplicationRunning(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
+	if isSuspendRequested(application) {
+		s.flinkController.LogEvent(ctx, application, corev1.EventTypeNormal, "Suspending", "Suspending the application")
+		s.updateApplicationPhase(application, v1beta1.FlinkApplicationSuspending)
+		return statusChanged, nil
+	}
+
 	cur, err := s.flinkController.GetCurrentDeploymentsForApp(ctx, application)
 	if err != nil {
 		return statusUnchanged, err
@@ -718,7 +1089,10 @@ func (s *FlinkStateMachine) handleApplicationRunning(ctx context.Context, applic
 		if s.isIncompatibleDeploymentModeChange(application) {
 			s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "UnsupportedChange",
 				fmt.Sprintf("Changing deployment mode from %s to %s is unsupported", application.Status.DeploymentMode, application.Spec.DeploymentMode))
-			return s.deployFailed(application)
+			return s.deployFailed(ctx, application)
+		}
+		if !s.resolveRestoreFrom(ctx, application) {
+			return s.deployFailed(ctx, application)
 		}
 		logger.Infof(ctx, "Application resource has changed. Moving to Updating")
 		// TODO: handle single mode
@@ -743,8 +1117,9 @@ func (s *FlinkStateMachine) handleApplicationRunning(ctx context.Context, applic
 		err = s.flinkController.DeleteResourcesForAppWithHash(ctx, application, application.Status.FailedDeployHash)
 		// Delete status object for the failed hash
 		s.flinkController.DeleteStatusPostTeardown(ctx, application, application.Status.FailedDeployHash)
-	} else if !v1beta1.IsBlueGreenDeploymentMode(application.Status.DeploymentMode) {
-		// If there are old resources left-over from a previous version, clean them up
+	} else if !v1beta1.IsBlueGreenDeploymentMode(application.Status.DeploymentMode) && !s.isAutoRollbackWindowOpen(application) {
+		// If there are old resources left-over from a previous version, clean them up. These are kept while the
+		// current version can still be rolled back automatically.
 		err = s.flinkController.DeleteOldResourcesForApp(ctx, application)
 	}
 
@@ -758,20 +1133,612 @@ func (s *FlinkStateMachine) handleApplicationRunning(ctx context.Context, applic
 		logger.Errorf(ctx, "Updating cluster status failed with %v", clusterErr)
 	}
 
+	// Changes to spec.podDisruptionBudget are applied to the running cluster, as they do not change the hash
+	if application.Status.DeployHash != "" {
+		if _, err := s.flinkController.UpdatePodDisruptionBudget(ctx, application, application.Status.DeployHash); err != nil {
+			logger.Warnf(ctx, "Failed to update the pod disruption budget: %v", err)
+		}
+	}
+
 	// Update status of jobs on the cluster
 	hasJobStatusChanged, jobsErr := s.flinkController.CompareAndUpdateJobStatus(ctx, application, application.Status.DeployHash)
 	if jobsErr != nil {
 		logger.Errorf(ctx, "Updating jobs status failed with %v", jobsErr)
 	}
 
+	if flink.IsBoundedJobDone(application, job) {
+		return s.finishBoundedJob(ctx, application, job)
+	}
+
+	hasAutoRollbackChanged, err := s.handleAutoRollback(ctx, application)
+	if err != nil || application.Status.Phase == v1beta1.FlinkApplicationRollingBackJob {
+		return hasAutoRollbackChanged, err
+	}
+
+	if application.Spec.Autoscaler == nil && application.Status.Autoscaler != nil {
+		// the autoscaler has been disabled, and the application now runs with spec.parallelism
+		flink.SetAutoscaledParallelism(application, 0)
+		if err := s.k8Cluster.UpdateK8Object(ctx, application); err != nil {
+			return statusUnchanged, err
+		}
+		application.Status.Autoscaler = nil
+		return statusChanged, nil
+	}
+
+	if s.shouldAutoscale(application) && s.autoscale(ctx, application) {
+		return statusChanged, nil
+	}
+
+	hasSavepointScheduleChanged := s.handleSavepointSchedule(ctx, application)
+	hasManualSavepointChanged := s.handleSavepointNonce(ctx, application)
+	hasSessionJobsChanged := s.handleSessionJobs(ctx, application)
+
 	// Update k8s object if either job or cluster status has changed
-	if hasJobStatusChanged || hasClusterStatusChanged {
+	if hasJobStatusChanged || hasClusterStatusChanged || hasSavepointScheduleChanged || hasManualSavepointChanged ||
+		hasAutoRollbackChanged || hasSessionJobsChanged {
 		return statusChanged, nil
 	}
 
 	return statusUnchanged, nil
 }
 
+// A freshly deployed version is monitored by the health checks of spec.autoRollback until the window has passed
+func (s *FlinkStateMachine) isAutoRollbackWindowOpen(app *v1beta1.FlinkApplication) bool {
+	status := app.Status.AutoRollback
+	if app.Spec.AutoRollback == nil || status == nil || status.Hash != app.Status.DeployHash ||
+		status.FailedCheck != "" {
+		return false
+	}
+	return s.clock.Since(status.DeployTime.Time) < flink.GetAutoRollbackWindow(app.Spec.AutoRollback)
+}
+
+// Runs the health checks against the current version while the auto-rollback window is open. If one of them fails,
+// the job of the current version is cancelled and the application moves to RollingBackJob, which submits the job of
+// the previous version to its cluster again. Returns true if the status has changed.
+func (s *FlinkStateMachine) handleAutoRollback(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
+	if !s.isAutoRollbackWindowOpen(app) {
+		return statusUnchanged, nil
+	}
+
+	status := app.Status.AutoRollback
+	observed := status.DeepCopy()
+	now := s.clock.Now()
+	flink.ObserveHealth(app, status, now)
+
+	for _, check := range flink.NewHealthChecks(app.Spec.AutoRollback) {
+		reason := check.Check(app, status, now)
+		if reason == "" {
+			continue
+		}
+
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "AutoRollback",
+			fmt.Sprintf("Health check %s failed for version %s, rolling back to %s: %s", check.Name(), status.Hash,
+				status.PreviousHash, reason))
+
+		// the job of the unhealthy version must be stopped before the previous job is started again
+		switch app.Status.JobStatus.State {
+		case v1beta1.Failed, v1beta1.Canceled, v1beta1.Finished:
+		default:
+			err := s.flinkController.ForceCancel(ctx, app, app.Status.DeployHash, s.flinkController.GetLatestJobID(ctx, app))
+			if err != nil {
+				return statusUnchanged, err
+			}
+		}
+
+		status.FailedCheck = check.Name()
+		status.Reason = reason
+		app.Status.DeployHash = status.PreviousHash
+		app.Status.SavepointPath = status.SavepointPath
+		previousJob := status.PreviousJob.DeepCopy()
+		s.flinkController.UpdateLatestJobStatus(ctx, app, *previousJob)
+		s.flinkController.UpdateLatestJobID(ctx, app, "")
+		s.updateApplicationPhase(app, v1beta1.FlinkApplicationRollingBackJob)
+		return statusChanged, nil
+	}
+
+	if apiequality.Semantic.DeepEqual(observed, status) {
+		return statusUnchanged, nil
+	}
+	return statusChanged, nil
+}
+
+// Decisions are only made for running jobs, once their metrics have had the cooldown period to settle after the job
+// was started or last rescaled
+func (s *FlinkStateMachine) shouldAutoscale(app *v1beta1.FlinkApplication) bool {
+	if app.Spec.Autoscaler == nil || v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
+		return false
+	}
+	// after a failed rescale has been reverted, the job keeps running on the version that is still deployed
+	if app.Status.Phase != v1beta1.FlinkApplicationRunning &&
+		(app.Status.Phase != v1beta1.FlinkApplicationDeployFailed || flink.HashForApplication(app) != app.Status.DeployHash) {
+		return false
+	}
+
+	jobStatus := app.Status.JobStatus
+	if jobStatus.State != v1beta1.Running || jobStatus.StartTime == nil {
+		return false
+	}
+
+	since := jobStatus.StartTime.Time
+	if status := app.Status.Autoscaler; status != nil && status.LastScaleTime != nil && status.LastScaleTime.After(since) {
+		since = status.LastScaleTime.Time
+	}
+	return s.clock.Since(since) >= flink.GetAutoscalerCooldown(app.Spec.Autoscaler)
+}
+
+// Computes the parallelism for the job from its metrics. If that differs from the current parallelism, it is pinned
+// in the AutoscaledParallelism annotation of the application, which restarts the job from a savepoint with the new
+// parallelism, and the decision is recorded in the status. Returns true if the job is being rescaled.
+func (s *FlinkStateMachine) autoscale(ctx context.Context, app *v1beta1.FlinkApplication) bool {
+	metric, utilization, err := s.flinkController.GetAutoscalerUtilization(ctx, app, app.Status.DeployHash)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to get the utilization of the job: %v", err)
+		return false
+	}
+	if metric == "" {
+		return false
+	}
+
+	current := flink.GetParallelism(app)
+	desired := flink.ComputeAutoscaledParallelism(app, utilization)
+	if desired == current {
+		return false
+	}
+
+	annotations := app.DeepCopy().Annotations
+	flink.SetAutoscaledParallelism(app, desired)
+	if err := s.k8Cluster.UpdateK8Object(ctx, app); err != nil {
+		logger.Warnf(ctx, "Failed to pin the autoscaled parallelism: %v", err)
+		app.Annotations = annotations
+		return false
+	}
+
+	now := v1.NewTime(s.clock.Now())
+	message := fmt.Sprintf("%s utilization of %.2f with a target of %.2f", metric, utilization,
+		flink.GetAutoscalerTargetUtilization(app.Spec.Autoscaler))
+
+	if app.Status.Autoscaler == nil {
+		app.Status.Autoscaler = &v1beta1.AutoscalerStatus{}
+	}
+	status := app.Status.Autoscaler
+	status.Parallelism = desired
+	status.SpecParallelism = app.Spec.Parallelism
+	status.LastScaleTime = &now
+	status.History = append(status.History, v1beta1.AutoscalerDecision{
+		Time:            now,
+		FromParallelism: current,
+		ToParallelism:   desired,
+		Metric:          metric,
+		Message:         message,
+	})
+	if len(status.History) > maxAutoscalerHistory {
+		status.History = status.History[len(status.History)-maxAutoscalerHistory:]
+	}
+
+	s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "Autoscaling",
+		fmt.Sprintf("Rescaling job from parallelism %d to %d due to %s", current, desired, message))
+	s.updateApplicationPhase(app, v1beta1.FlinkApplicationUpdating)
+	return true
+}
+
+// Takes the savepoints configured by spec.savepointSchedule while the job is running, tracks their progress in the
+// status and expires those that are no longer retained. Returns true if the status has changed.
+func (s *FlinkStateMachine) handleSavepointSchedule(ctx context.Context, app *v1beta1.FlinkApplication) bool {
+	schedule := app.Spec.SavepointSchedule
+	if schedule == nil {
+		return false
+	}
+
+	changed := false
+	inProgress := false
+	if status := app.Status.SavepointSchedule; status != nil {
+		for i := range status.Savepoints {
+			if status.Savepoints[i].State == v1beta1.SavepointInProgress {
+				changed = s.updateScheduledSavepoint(ctx, app, &status.Savepoints[i]) || changed
+				inProgress = inProgress || status.Savepoints[i].State == v1beta1.SavepointInProgress
+			}
+		}
+	}
+
+	jobStatus := app.Status.JobStatus
+	if !inProgress && jobStatus.State == v1beta1.Running && jobStatus.StartTime != nil {
+		next, err := savepoint.NextTime(schedule, app.Status.SavepointSchedule, jobStatus.StartTime.Time)
+		if err != nil {
+			logger.Errorf(ctx, "Invalid savepoint schedule: %v", err)
+		} else if !s.clock.Now().Before(next) {
+			s.triggerScheduledSavepoint(ctx, app)
+			changed = true
+		}
+	}
+
+	if app.Status.SavepointSchedule != nil && s.expireScheduledSavepoints(ctx, app) {
+		changed = true
+	}
+	return changed
+}
+
+func (s *FlinkStateMachine) triggerScheduledSavepoint(ctx context.Context, app *v1beta1.FlinkApplication) {
+	now := v1.NewTime(s.clock.Now())
+	jobID := s.flinkController.GetLatestJobID(ctx, app)
+	scheduled := v1beta1.ScheduledSavepoint{
+		JobID:       jobID,
+		TriggerTime: now,
+		State:       v1beta1.SavepointInProgress,
+	}
+
+	triggerID, err := s.flinkController.Savepoint(ctx, app, app.Status.DeployHash, false, jobID)
+	if err != nil {
+		// the failure is recorded, and the savepoint is retried at the next scheduled time
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "ScheduledSavepointFailed",
+			fmt.Sprintf("Failed to trigger scheduled savepoint for job %s: %v", jobID, err))
+		scheduled.State = v1beta1.SavepointFailed
+		scheduled.CompletionTime = &now
+		scheduled.FailureCause = err.Error()
+	} else {
+		logger.Infof(ctx, "Triggered scheduled savepoint %s for job %s", triggerID, jobID)
+		scheduled.TriggerID = triggerID
+	}
+
+	if app.Status.SavepointSchedule == nil {
+		app.Status.SavepointSchedule = &v1beta1.SavepointScheduleStatus{}
+	}
+	app.Status.SavepointSchedule.LastTriggerTime = &now
+	app.Status.SavepointSchedule.Savepoints = append(app.Status.SavepointSchedule.Savepoints, scheduled)
+}
+
+// Polls a scheduled savepoint that is in progress, and records its result once it has completed. Returns true if the
+// savepoint has completed.
+func (s *FlinkStateMachine) updateScheduledSavepoint(ctx context.Context, app *v1beta1.FlinkApplication, scheduled *v1beta1.ScheduledSavepoint) bool {
+	state, result := s.pollSavepoint(ctx, app, scheduled.JobID, scheduled.TriggerID)
+	now := v1.NewTime(s.clock.Now())
+	switch state {
+	case v1beta1.SavepointFailed:
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "ScheduledSavepointFailed",
+			fmt.Sprintf("Failed to take scheduled savepoint for job %s: %s", scheduled.JobID, result))
+		scheduled.FailureCause = result
+	case v1beta1.SavepointSucceeded:
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "ScheduledSavepointCompleted",
+			fmt.Sprintf("Completed scheduled savepoint at %s", result))
+		scheduled.Location = result
+		s.recordSavepoint(app, result, v1beta1.SavepointReasonScheduled, scheduled.JobID, app.Status.DeployHash)
+	default:
+		return false
+	}
+
+	scheduled.State = state
+	scheduled.CompletionTime = &now
+	return true
+}
+
+// Polls a savepoint that was taken without stopping the job. Returns the state of the savepoint, along with its
+// location once it has succeeded or the cause of the failure once it has failed.
+func (s *FlinkStateMachine) pollSavepoint(ctx context.Context, app *v1beta1.FlinkApplication, jobID string, triggerID string) (v1beta1.SavepointState, string) {
+	if jobID != s.flinkController.GetLatestJobID(ctx, app) {
+		return v1beta1.SavepointFailed, "the job was replaced before the savepoint completed"
+	}
+
+	response, err := s.flinkController.GetSavepointStatusForTrigger(ctx, app, app.Status.DeployHash, jobID, triggerID)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to get the status of savepoint %s: %v", triggerID, err)
+		return v1beta1.SavepointInProgress, ""
+	}
+
+	if response.Operation.Location == "" && response.SavepointStatus.Status != client.SavePointInProgress {
+		return v1beta1.SavepointFailed, response.Operation.FailureCause.Class
+	} else if response.SavepointStatus.Status == client.SavePointCompleted {
+		return v1beta1.SavepointSucceeded, response.Operation.Location
+	}
+	return v1beta1.SavepointInProgress, ""
+}
+
+// Adds a completed savepoint to status.savepointHistory, dropping the oldest entries beyond the limit
+func (s *FlinkStateMachine) recordSavepoint(app *v1beta1.FlinkApplication, path string, reason v1beta1.SavepointReason,
+	jobID string, hash string) {
+	app.Status.SavepointHistory = append(app.Status.SavepointHistory, v1beta1.SavepointRecord{
+		Path:   path,
+		Reason: reason,
+		JobID:  jobID,
+		Hash:   hash,
+		Time:   v1.NewTime(s.clock.Now()),
+	})
+	if len(app.Status.SavepointHistory) > maxSavepointHistory {
+		app.Status.SavepointHistory = app.Status.SavepointHistory[len(app.Status.SavepointHistory)-maxSavepointHistory:]
+	}
+}
+
+// Removes a savepoint that no longer exists from status.savepointHistory, so that it can't be restored from
+func removeSavepointFromHistory(app *v1beta1.FlinkApplication, path string) {
+	var history []v1beta1.SavepointRecord
+	for _, record := range app.Status.SavepointHistory {
+		if record.Path != path {
+			history = append(history, record)
+		}
+	}
+	app.Status.SavepointHistory = history
+}
+
+// Takes a savepoint whenever spec.savepointNonce is changed, without stopping the job, and records its result in
+// status.manualSavepoint. Returns true if the status has changed.
+func (s *FlinkStateMachine) handleSavepointNonce(ctx context.Context, app *v1beta1.FlinkApplication) bool {
+	nonce := app.Spec.SavepointNonce
+	manual := app.Status.ManualSavepoint
+	if nonce == "" {
+		return false
+	}
+
+	if manual == nil || manual.Nonce != nonce {
+		// a new savepoint has been requested; wait for the job to be running before triggering it
+		if app.Status.JobStatus.State != v1beta1.Running {
+			return false
+		}
+
+		now := v1.NewTime(s.clock.Now())
+		jobID := s.flinkController.GetLatestJobID(ctx, app)
+		manual = &v1beta1.ManualSavepoint{
+			Nonce:       nonce,
+			JobID:       jobID,
+			TriggerTime: now,
+			State:       v1beta1.SavepointInProgress,
+		}
+		app.Status.ManualSavepoint = manual
+
+		triggerID, err := s.flinkController.Savepoint(ctx, app, app.Status.DeployHash, false, jobID)
+		if err != nil {
+			// the savepoint is not retried until the nonce is changed again
+			s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "ManualSavepointFailed",
+				fmt.Sprintf("Failed to trigger savepoint for job %s: %v", jobID, err))
+			manual.State = v1beta1.SavepointFailed
+			manual.CompletionTime = &now
+			manual.FailureCause = err.Error()
+			return true
+		}
+
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "ManualSavepointTriggered",
+			fmt.Sprintf("Triggered savepoint for job %s", jobID))
+		manual.TriggerID = triggerID
+		return true
+	}
+
+	if manual.State != v1beta1.SavepointInProgress {
+		return false
+	}
+
+	state, result := s.pollSavepoint(ctx, app, manual.JobID, manual.TriggerID)
+	now := v1.NewTime(s.clock.Now())
+	switch state {
+	case v1beta1.SavepointFailed:
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "ManualSavepointFailed",
+			fmt.Sprintf("Failed to take savepoint for job %s: %s", manual.JobID, result))
+		app.Status.SetCondition(v1beta1.ConditionSavepointSucceeded, corev1.ConditionFalse, "SavepointFailed", result)
+		manual.FailureCause = result
+	case v1beta1.SavepointSucceeded:
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "ManualSavepointCompleted",
+			fmt.Sprintf("Completed savepoint at %s", result))
+		app.Status.SetCondition(v1beta1.ConditionSavepointSucceeded, corev1.ConditionTrue, "SavepointCompleted", result)
+		manual.Location = result
+		s.recordSavepoint(app, result, v1beta1.SavepointReasonManual, manual.JobID, app.Status.DeployHash)
+	default:
+		return false
+	}
+
+	manual.State = state
+	manual.CompletionTime = &now
+	return true
+}
+
+// Removes the scheduled savepoints that are no longer retained from the status, deleting them from storage first if
+// configured. Savepoints that fail to be deleted are kept, so that the deletion is retried. Returns true if any
+// savepoints were removed.
+func (s *FlinkStateMachine) expireScheduledSavepoints(ctx context.Context, app *v1beta1.FlinkApplication) bool {
+	schedule := app.Spec.SavepointSchedule
+	status := app.Status.SavepointSchedule
+	retained, expired := savepoint.ApplyRetention(schedule, status.Savepoints, s.clock.Now())
+	if len(expired) == 0 && len(retained) == len(status.Savepoints) {
+		return false
+	}
+
+	for _, expiredSavepoint := range expired {
+		if schedule.DeleteExpired {
+			if err := s.savepointStorage.Delete(ctx, expiredSavepoint.Location); err != nil {
+				// the savepoint is kept in the status with the failure, so that the deletion is not retried
+				s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "SavepointDeletionFailed",
+					fmt.Sprintf("Failed to delete expired savepoint %s: %v", expiredSavepoint.Location, err))
+				expiredSavepoint.DeletionFailure = err.Error()
+				retained = append(retained, expiredSavepoint)
+				continue
+			}
+			logger.Infof(ctx, "Deleted expired savepoint %s", expiredSavepoint.Location)
+			removeSavepointFromHistory(app, expiredSavepoint.Location)
+		}
+	}
+
+	sort.SliceStable(retained, func(i, j int) bool {
+		return retained[i].TriggerTime.Before(&retained[j].TriggerTime)
+	})
+	status.Savepoints = retained
+	return true
+}
+
+// Submits the jobs in spec.jobs to the cluster of the current version, and keeps their status up to date. A job whose
+// spec has changed is stopped with a savepoint and submitted again from it, and a job that has been removed from the
+// spec is stopped with a savepoint, without affecting the main job or the other jobs. Returns true if the status has
+// changed.
+func (s *FlinkStateMachine) handleSessionJobs(ctx context.Context, app *v1beta1.FlinkApplication) bool {
+	// the jobs are only submitted once the first deploy has succeeded
+	if (len(app.Spec.Jobs) == 0 && len(app.Status.Jobs) == 0) || app.Status.DeployHash == "" {
+		return false
+	}
+
+	states, err := s.getSessionJobStates(ctx, app)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to get the jobs on the cluster: %v", err)
+		return false
+	}
+
+	var statuses []v1beta1.SessionJobStatus
+	for i := range app.Spec.Jobs {
+		job := &app.Spec.Jobs[i]
+		status := v1beta1.SessionJobStatus{Name: job.Name, SavepointPath: job.SavepointPath}
+		if existing := flink.GetSessionJobStatus(app, job.Name); existing != nil {
+			status = *existing.DeepCopy()
+		}
+		s.updateSessionJob(ctx, app, job, &status, states)
+		statuses = append(statuses, status)
+	}
+
+	for i := range app.Status.Jobs {
+		status := *app.Status.Jobs[i].DeepCopy()
+		if !flink.IsSessionJobRemoved(app, status.Name) {
+			continue
+		}
+		if s.stopSessionJob(ctx, app, &status, states) {
+			s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "JobRemoved",
+				fmt.Sprintf("Removed job %s from the cluster", status.Name))
+			continue
+		}
+		statuses = append(statuses, status)
+	}
+
+	changed := !apiequality.Semantic.DeepEqual(app.Status.Jobs, statuses)
+	app.Status.Jobs = statuses
+	return changed
+}
+
+// Returns the state of each job on the cluster of the current version, by job id
+func (s *FlinkStateMachine) getSessionJobStates(ctx context.Context, app *v1beta1.FlinkApplication) (map[string]client.JobState, error) {
+	jobs, err := s.flinkController.GetJobsForApplication(ctx, app, app.Status.DeployHash)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]client.JobState, len(jobs))
+	for _, job := range jobs {
+		states[job.JobID] = job.Status
+	}
+	return states, nil
+}
+
+func (s *FlinkStateMachine) updateSessionJob(ctx context.Context, app *v1beta1.FlinkApplication, job *v1beta1.SessionJob,
+	status *v1beta1.SessionJobStatus, states map[string]client.JobState) {
+	hash := flink.HashForSessionJob(job)
+	if status.JobStatus.JobID != "" {
+		state, found := states[status.JobStatus.JobID]
+		if found && status.Hash == hash {
+			status.JobStatus.State = v1beta1.JobState(state)
+			return
+		}
+		// the spec has changed, or the job is no longer on the cluster, as after the cluster has been replaced
+		if !s.stopSessionJob(ctx, app, status, states) {
+			return
+		}
+	}
+
+	jobID, err := s.flinkController.StartFlinkJob(ctx, app, app.Status.DeployHash, job.JarName, job.Parallelism,
+		job.EntryClass, job.ProgramArgs, job.AllowNonRestoredState, status.SavepointPath)
+	if err != nil {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "JobSubmissionFailed",
+			fmt.Sprintf("Failed to submit job %s to cluster for deploy %s: %v", job.Name, app.Status.DeployHash, err))
+		return
+	}
+
+	s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "JobSubmitted",
+		fmt.Sprintf("Flink job %s submitted to cluster with id %s", job.Name, jobID))
+	status.Hash = hash
+	status.JobStatus = v1beta1.FlinkJobStatus{
+		JobID:                 jobID,
+		State:                 v1beta1.Created,
+		JarName:               job.JarName,
+		Parallelism:           job.Parallelism,
+		EntryClass:            job.EntryClass,
+		ProgramArgs:           job.ProgramArgs,
+		AllowNonRestoredState: job.AllowNonRestoredState,
+		RestorePath:           status.SavepointPath,
+	}
+}
+
+// Cancels a job in spec.jobs with a savepoint, which it is submitted from the next time. A job that is no longer
+// running on the cluster has nothing to savepoint, and keeps its previous savepoint. Returns true once the job has
+// stopped.
+func (s *FlinkStateMachine) stopSessionJob(ctx context.Context, app *v1beta1.FlinkApplication,
+	status *v1beta1.SessionJobStatus, states map[string]client.JobState) bool {
+	jobID := status.JobStatus.JobID
+	if jobID == "" {
+		return true
+	}
+	if state, found := states[jobID]; !found || state == client.Canceled || state == client.Failed ||
+		state == client.Finished {
+		status.JobStatus.JobID = ""
+		status.SavepointTriggerID = ""
+		return true
+	}
+
+	hash := app.Status.DeployHash
+	if status.SavepointTriggerID == "" {
+		triggerID, err := s.flinkController.Savepoint(ctx, app, hash, true, jobID)
+		if err != nil {
+			logger.Errorf(ctx, "Failed to trigger a savepoint of job %s: %v", status.Name, err)
+			return false
+		}
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "CancellingJob",
+			fmt.Sprintf("Cancelling job %s with a savepoint", status.Name))
+		status.SavepointTriggerID = triggerID
+		return false
+	}
+
+	response, err := s.flinkController.GetSavepointStatusForTrigger(ctx, app, hash, jobID, status.SavepointTriggerID)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to get the savepoint of job %s: %v", status.Name, err)
+		return false
+	}
+
+	if response.Operation.Location == "" && response.SavepointStatus.Status != client.SavePointInProgress {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "SavepointFailed",
+			fmt.Sprintf("Failed to take savepoint for job %s: %v", status.Name, response.Operation.FailureCause))
+		// clear the trigger id so that we can try again
+		status.SavepointTriggerID = ""
+		return false
+	} else if response.SavepointStatus.Status != client.SavePointCompleted {
+		return false
+	}
+
+	s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "CanceledJob",
+		fmt.Sprintf("Canceled job %s with savepoint %s", status.Name, response.Operation.Location))
+	status.SavepointPath = response.Operation.Location
+	status.SavepointTriggerID = ""
+	status.JobStatus.JobID = ""
+	status.JobStatus.State = v1beta1.Canceled
+	return true
+}
+
+// Stops all jobs in spec.jobs with a savepoint, before the cluster they run on is replaced or deleted. Returns
+// whether all of them have stopped, and whether the status has changed.
+func (s *FlinkStateMachine) stopSessionJobs(ctx context.Context, app *v1beta1.FlinkApplication) (bool, bool) {
+	running := false
+	for i := range app.Status.Jobs {
+		running = running || app.Status.Jobs[i].JobStatus.JobID != ""
+	}
+	if !running {
+		return true, false
+	}
+
+	states, err := s.getSessionJobStates(ctx, app)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to get the jobs on the cluster: %v", err)
+		return false, false
+	}
+
+	statuses := make([]v1beta1.SessionJobStatus, len(app.Status.Jobs))
+	stopped := true
+	for i := range app.Status.Jobs {
+		statuses[i] = *app.Status.Jobs[i].DeepCopy()
+		stopped = s.stopSessionJob(ctx, app, &statuses[i], states) && stopped
+	}
+
+	changed := !apiequality.Semantic.DeepEqual(app.Status.Jobs, statuses)
+	app.Status.Jobs = statuses
+	return stopped, changed
+}
+
 func (s *FlinkStateMachine) addFinalizerIfMissing(ctx context.Context, application *v1beta1.FlinkApplication, finalizer string) error {
 	for _, f := range application.Finalizers {
 		if f == finalizer {
@@ -796,6 +1763,11 @@ func removeString(list []string, target string) []string {
 }
 
 func (s *FlinkStateMachine) clearFinalizers(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
+	// the HA ConfigMaps are not owned by the application, so they would outlive it
+	if err := s.flinkController.DeleteHAConfigMapsForApp(ctx, app); err != nil {
+		return statusUnchanged, err
+	}
+
 	app.Finalizers = removeString(app.Finalizers, jobFinalizer)
 	return statusUnchanged, s.k8Cluster.UpdateK8Object(ctx, app)
 }
@@ -818,6 +1790,10 @@ func (s *FlinkStateMachine) handleApplicationDeleting(ctx context.Context, app *
 	if app.Spec.DeleteMode == v1beta1.DeleteModeNone || app.Status.DeployHash == "" {
 		return s.clearFinalizers(ctx, app)
 	}
+	// a bounded job that has finished or failed has no state left to savepoint
+	if app.Status.FinishedJob != nil {
+		return s.clearFinalizers(ctx, app)
+	}
 	if v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
 		return s.deleteBlueGreenApplication(ctx, app)
 	}
@@ -882,6 +1858,8 @@ func (s *FlinkStateMachine) handleApplicationDeleting(ctx context.Context, app *
 					fmt.Sprintf("Cancelled job with savepoint '%s'", status.Operation.Location))
 				app.Status.SavepointPath = status.Operation.Location
 				app.Status.SavepointTriggerID = ""
+				s.recordSavepoint(app, status.Operation.Location, v1beta1.SavepointReasonDelete,
+					s.flinkController.GetLatestJobID(ctx, app), app.Status.DeployHash)
 			}
 		}
 
@@ -944,13 +1922,20 @@ func getCancelFlag(app *v1beta1.FlinkApplication) bool {
 // Two applications are running in this phase. This phase is only ever reached when the
 // DeploymentMode is set to BlueGreen
 func (s *FlinkStateMachine) handleDualRunning(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
+	if isSuspendRequested(application) {
+		// the new version is kept and suspended once the old one has been torn down
+		s.flinkController.LogEvent(ctx, application, corev1.EventTypeNormal, "Suspending",
+			fmt.Sprintf("Tearing down version %s before suspending the application", application.Status.DeployHash))
+		return s.teardownApplicationVersion(ctx, application, application.Status.DeployHash)
+	}
+
 	if application.Spec.TearDownVersionHash != "" {
 		versionHashToTeardown := application.Spec.TearDownVersionHash
 		_, _, err := s.flinkController.GetVersionAndJobIDForHash(ctx, application, versionHashToTeardown)
 		if err != nil {
 			logger.Warnf(ctx, "Cannot find flink application with tearDownVersionhash %s. The hash may be obsolete; Ignoring hash", versionHashToTeardown)
 		} else {
-			return s.teardownApplicationVersion(ctx, application)
+			return s.teardownApplicationVersion(ctx, application, versionHashToTeardown)
 		}
 	}
 
@@ -966,6 +1951,24 @@ func (s *FlinkStateMachine) handleDualRunning(ctx context.Context, application *
 		logger.Errorf(ctx, "Updating jobs status failed with %v", jobsErr)
 	}
 
+	if application.Spec.BoundedJob != nil {
+		// the job of the new version may already have finished when it is first observed
+		job, err := s.flinkController.GetJobForApplication(ctx, application, application.Status.UpdatingHash)
+		if err != nil {
+			return statusUnchanged, err
+		}
+		if flink.IsBoundedJobDone(application, job) {
+			return s.finishBlueGreenBoundedJob(ctx, application, job)
+		}
+	}
+
+	if policy := flink.GetPromotionPolicy(application); policy != nil {
+		hasPromotionChanged, err := s.handlePromotion(ctx, application, policy)
+		if hasPromotionChanged || err != nil {
+			return hasPromotionChanged, err
+		}
+	}
+
 	// Update k8s object if either job or cluster status has changed
 	if hasJobStatusChanged || hasClusterStatusChanged {
 		return statusChanged, nil
@@ -973,8 +1976,67 @@ func (s *FlinkStateMachine) handleDualRunning(ctx context.Context, application *
 	return statusUnchanged, nil
 }
 
-func (s *FlinkStateMachine) teardownApplicationVersion(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
-	versionHashToTeardown := application.Spec.TearDownVersionHash
+// Once the bounded job of the new version has finished, the new version replaces the old one, which is torn down. The
+// application then moves from Running to Succeeded, like in the Dual deployment mode. If the job has failed, the new
+// version is torn down instead, as when it fails promotion.
+func (s *FlinkStateMachine) finishBlueGreenBoundedJob(ctx context.Context, app *v1beta1.FlinkApplication,
+	job *client.FlinkJobOverview) (bool, error) {
+	if job.State == client.Finished {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "JobFinished",
+			fmt.Sprintf("Job %s of version %s has finished, replacing the previous version", job.JobID,
+				app.Status.UpdatingHash))
+		return s.teardownApplicationVersion(ctx, app, app.Status.DeployHash)
+	}
+
+	s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "JobFailed",
+		fmt.Sprintf("Job %s of version %s has failed, keeping the previous version", job.JobID,
+			app.Status.UpdatingHash))
+	return s.teardownApplicationVersion(ctx, app, app.Status.UpdatingHash)
+}
+
+// Evaluates the job of the new version against spec.blueGreen.promotionPolicy once it is running alongside the old
+// version. The old version is torn down once the new one has passed the checks, and the new version is torn down
+// instead if it fails them. Returns true if the status has changed.
+func (s *FlinkStateMachine) handlePromotion(ctx context.Context, app *v1beta1.FlinkApplication, policy *v1beta1.PromotionPolicy) (bool, error) {
+	now := v1.NewTime(s.clock.Now())
+	jobStatus := s.flinkController.GetLatestJobStatus(ctx, app)
+	promotion := app.Status.Promotion
+	if promotion == nil || promotion.Hash != app.Status.UpdatingHash {
+		// the soak window of a new version starts once both versions are running
+		app.Status.Promotion = &v1beta1.PromotionStatus{
+			Hash:                   app.Status.UpdatingHash,
+			SoakStartTime:          now,
+			InitialJobRestartCount: jobStatus.JobRestartCount,
+			Decision:               v1beta1.PromotionPending,
+		}
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "PromotionStarted",
+			fmt.Sprintf("Evaluating version %s for %s before promoting it", app.Status.UpdatingHash,
+				flink.GetPromotionSoakDuration(policy)))
+		return statusChanged, nil
+	}
+
+	if promotion.Decision == v1beta1.PromotionPending {
+		decision, reason := flink.EvaluatePromotion(policy, promotion, jobStatus, now.Time)
+		if decision == v1beta1.PromotionPending {
+			return statusUnchanged, nil
+		}
+		promotion.Decision = decision
+		promotion.Reason = reason
+		promotion.DecisionTime = &now
+	}
+
+	if promotion.Decision == v1beta1.PromotionPromoted {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "PromotionSucceeded",
+			fmt.Sprintf("Promoting version %s: %s", promotion.Hash, promotion.Reason))
+		return s.teardownApplicationVersion(ctx, app, app.Status.DeployHash)
+	}
+
+	s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "PromotionFailed",
+		fmt.Sprintf("Rolling back version %s: %s", promotion.Hash, promotion.Reason))
+	return s.teardownApplicationVersion(ctx, app, promotion.Hash)
+}
+
+func (s *FlinkStateMachine) teardownApplicationVersion(ctx context.Context, application *v1beta1.FlinkApplication, versionHashToTeardown string) (bool, error) {
 	versionToTeardown, jobID, _ := s.flinkController.GetVersionAndJobIDForHash(ctx, application, versionHashToTeardown)
 
 	s.flinkController.LogEvent(ctx, application, corev1.EventTypeNormal, "TeardownInitated",
@@ -990,7 +2052,7 @@ func (s *FlinkStateMachine) teardownApplicationVersion(ctx context.Context, appl
 		s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "TeardownFailed",
 			fmt.Sprintf("Failed to force-cancel application version %v and hash %s; will attempt to tear down cluster immediately: %s",
 				versionToTeardown, versionHashToTeardown, err))
-		return s.deployFailed(application)
+		return s.deployFailed(ctx, application)
 	}
 
 	// Delete all resources associated with the teardown version
@@ -999,7 +2061,7 @@ func (s *FlinkStateMachine) teardownApplicationVersion(ctx context.Context, appl
 		s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "TeardownFailed",
 			fmt.Sprintf("Failed to teardown application with hash %s and version %v, manual intervention needed: %s", versionHashToTeardown,
 				versionToTeardown, err))
-		return s.deployFailed(application)
+		return s.deployFailed(ctx, application)
 	}
 	s.flinkController.LogEvent(ctx, application, corev1.EventTypeWarning, "TeardownCompleted",
 		fmt.Sprintf("Tore down application with hash %s and version %v", versionHashToTeardown,
@@ -1100,6 +2162,7 @@ func (s *FlinkStateMachine) cancelAndDeleteJob(ctx context.Context, app *v1beta1
 					fmt.Sprintf("Cancelled job with savepoint '%s'", status.Operation.Location))
 				app.Status.SavepointPath = status.Operation.Location
 				app.Status.SavepointTriggerID = ""
+				s.recordSavepoint(app, status.Operation.Location, v1beta1.SavepointReasonDelete, job.JobID, hash)
 			}
 		}
 
@@ -1111,8 +2174,188 @@ func (s *FlinkStateMachine) cancelAndDeleteJob(ctx context.Context, app *v1beta1
 	return statusUnchanged, nil
 }
 
+func isSuspendRequested(app *v1beta1.FlinkApplication) bool {
+	return app.Spec.DesiredState == v1beta1.DesiredStateSuspended
+}
+
+// Cancels the job with a savepoint and then deletes its cluster, so that the application no longer uses any resources
+// until it is resumed. The savepoint is kept in the status, and the job is started from it on resume.
+func (s *FlinkStateMachine) handleApplicationSuspending(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
+	hash := app.Status.DeployHash
+	if hash == "" {
+		// the first deploy has failed, so there is only the cluster of the failed deploy to delete
+		hash = flink.HashForApplication(app)
+	}
+	jobID := s.flinkController.GetLatestJobID(ctx, app)
+
+	if app.Status.SavepointPath == "" && jobID != "" {
+		if app.Status.SavepointTriggerID == "" {
+			if !isSuspendRequested(app) {
+				// the application was resumed before its job was stopped
+				s.updateApplicationPhase(app, v1beta1.FlinkApplicationRunning)
+				return statusChanged, nil
+			}
+
+			triggerID, err := s.flinkController.Savepoint(ctx, app, hash, true, jobID)
+			if err != nil {
+				return statusUnchanged, err
+			}
+			s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "CancellingJob",
+				fmt.Sprintf("Cancelling job %s with a savepoint to suspend the application", jobID))
+			app.Status.SavepointTriggerID = triggerID
+			return statusChanged, nil
+		}
+
+		status, err := s.flinkController.GetSavepointStatus(ctx, app, hash, jobID)
+		if err != nil {
+			return statusUnchanged, err
+		}
+
+		if status.Operation.Location == "" && status.SavepointStatus.Status != client.SavePointInProgress {
+			s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "SavepointFailed",
+				fmt.Sprintf("Failed to take savepoint to suspend the application %v", status.Operation.FailureCause))
+			// clear the trigger id so that we can try again
+			app.Status.SavepointTriggerID = ""
+			return statusChanged, client.GetRetryableError(errors.New("failed to take savepoint"),
+				v1beta1.CancelJobWithSavepoint, "500", math.MaxInt32)
+		} else if status.SavepointStatus.Status != client.SavePointCompleted {
+			return statusUnchanged, nil
+		}
+
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "CanceledJob",
+			fmt.Sprintf("Canceled job with savepoint %s", status.Operation.Location))
+		app.Status.SavepointPath = status.Operation.Location
+		app.Status.SavepointTriggerID = ""
+		s.recordSavepoint(app, status.Operation.Location, v1beta1.SavepointReasonSuspend, jobID, hash)
+		return statusChanged, nil
+	}
+
+	if stopped, changed := s.stopSessionJobs(ctx, app); !stopped {
+		return changed, nil
+	}
+
+	// the job has stopped, so its cluster can be released, and on resume the application is deployed like a new
+	// one, starting from the savepoint
+	if err := s.releaseCluster(ctx, app, hash); err != nil {
+		return statusUnchanged, err
+	}
+
+	s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "Suspended",
+		fmt.Sprintf("Suspended the application and deleted the cluster for deploy %s", hash))
+	s.updateApplicationPhase(app, v1beta1.FlinkApplicationSuspended)
+	return statusChanged, nil
+}
+
+// The application stays suspended until spec.desiredState is set back to Running, at which point a new cluster is
+// created for the current spec and the job is started from the savepoint taken when it was suspended
+func (s *FlinkStateMachine) handleApplicationSuspended(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
+	if isSuspendRequested(app) {
+		return statusUnchanged, nil
+	}
+
+	if !s.resolveRestoreFrom(ctx, app) {
+		return s.deployFailed(ctx, app)
+	}
+	s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "Resuming",
+		fmt.Sprintf("Resuming the application from savepoint %s", flink.GetSavepointPathForDeploy(app)))
+	s.updateApplicationPhase(app, v1beta1.FlinkApplicationUpdating)
+	return statusChanged, nil
+}
+
+// Deletes the cluster of the given version once its job has stopped, and resets the status so that the application
+// is deployed like a new one from then on
+func (s *FlinkStateMachine) releaseCluster(ctx context.Context, app *v1beta1.FlinkApplication, hash string) error {
+	err := s.flinkController.DeleteResourcesForAppWithHash(ctx, app, hash)
+	if err != nil {
+		return err
+	}
+
+	s.flinkController.UpdateLatestJobID(ctx, app, "")
+	s.flinkController.UpdateLatestClusterStatus(ctx, app, v1beta1.FlinkClusterStatus{})
+	if v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
+		app.Status.VersionStatuses = make([]v1beta1.FlinkApplicationVersionStatus,
+			v1beta1.GetMaxRunningJobs(app.Status.DeploymentMode))
+		app.Status.DeployVersion = ""
+		app.Status.UpdatingVersion = ""
+		app.Status.UpdatingHash = ""
+	}
+	app.Status.DeployHash = ""
+	app.Status.FailedDeployHash = ""
+	app.Status.RollbackHash = ""
+	app.Status.TeardownHash = ""
+	app.Status.AutoRollback = nil
+	return nil
+}
+
+// Records the final state of a bounded job that has finished or failed, and moves the application to the
+// corresponding terminal phase. The cluster is kept until spec.boundedJob.ttlAfterFinished has passed.
+func (s *FlinkStateMachine) finishBoundedJob(ctx context.Context, app *v1beta1.FlinkApplication,
+	job *client.FlinkJobOverview) (bool, error) {
+	finishTime := s.clock.Now()
+	if job.EndTime > 0 {
+		finishTime = time.Unix(0, job.EndTime*int64(time.Millisecond))
+	}
+	jobStatus := s.flinkController.GetLatestJobStatus(ctx, app)
+	app.Status.FinishedJob = &v1beta1.FinishedJobStatus{
+		Hash:         app.Status.DeployHash,
+		JobStatus:    *jobStatus.DeepCopy(),
+		FinishTime:   v1.NewTime(finishTime),
+		RestartNonce: app.Spec.RestartNonce,
+	}
+
+	if job.State == client.Finished {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "JobFinished",
+			fmt.Sprintf("Job %s has finished", job.JobID))
+		s.updateApplicationPhase(app, v1beta1.FlinkApplicationSucceeded)
+	} else {
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeWarning, "JobFailed",
+			fmt.Sprintf("Job %s has failed", job.JobID))
+		s.updateApplicationPhase(app, v1beta1.FlinkApplicationFailed)
+	}
+	return statusChanged, nil
+}
+
+// A bounded job stays finished until spec.restartNonce is changed, at which point it is run again on a new cluster.
+// The cluster of the finished job is deleted once spec.boundedJob.ttlAfterFinished has passed.
+func (s *FlinkStateMachine) handleApplicationFinished(ctx context.Context, app *v1beta1.FlinkApplication) (bool, error) {
+	finished := app.Status.FinishedJob
+	if finished == nil {
+		return statusUnchanged, nil
+	}
+
+	if app.Spec.RestartNonce != finished.RestartNonce {
+		if !finished.ClusterDeleted {
+			if err := s.releaseCluster(ctx, app, finished.Hash); err != nil {
+				return statusUnchanged, err
+			}
+		}
+		// the job is run again from the start, rather than from a savepoint of a previous run
+		app.Status.SavepointPath = ""
+		app.Status.FinishedJob = nil
+		s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "Restarting",
+			"Running the bounded job again after spec.restartNonce changed")
+		s.updateApplicationPhase(app, v1beta1.FlinkApplicationUpdating)
+		return statusChanged, nil
+	}
+
+	if finished.ClusterDeleted || app.Spec.BoundedJob == nil ||
+		s.clock.Since(finished.FinishTime.Time) < flink.GetJobTTLAfterFinished(app.Spec.BoundedJob) {
+		return statusUnchanged, nil
+	}
+
+	if err := s.releaseCluster(ctx, app, finished.Hash); err != nil {
+		return statusUnchanged, err
+	}
+	finished.ClusterDeleted = true
+	s.flinkController.LogEvent(ctx, app, corev1.EventTypeNormal, "ClusterDeleted",
+		fmt.Sprintf("Deleted the cluster for deploy %s after the job finished", finished.Hash))
+	return statusChanged, nil
+}
+
 func (s *FlinkStateMachine) isIncompatibleDeploymentModeChange(application *v1beta1.FlinkApplication) bool {
-	return application.Spec.DeploymentMode != application.Status.DeploymentMode
+	// an unset deployment mode is equivalent to Dual, which the defaulting webhook may fill in
+	return v1beta1.IsBlueGreenDeploymentMode(application.Spec.DeploymentMode) !=
+		v1beta1.IsBlueGreenDeploymentMode(application.Status.DeploymentMode)
 }
 
 func createRetryHandler() client.RetryHandlerInterface {
@@ -1124,10 +2367,11 @@ func NewFlinkStateMachine(k8sCluster k8.ClusterInterface, eventRecorder record.E
 
 	metrics := newStateMachineMetrics(config.MetricsScope)
 	return &FlinkStateMachine{
-		k8Cluster:       k8sCluster,
-		flinkController: flink.NewController(k8sCluster, eventRecorder, config),
-		clock:           clock.RealClock{},
-		metrics:         metrics,
-		retryHandler:    createRetryHandler(),
+		k8Cluster:        k8sCluster,
+		flinkController:  flink.NewController(k8sCluster, eventRecorder, config),
+		clock:            clock.RealClock{},
+		metrics:          metrics,
+		retryHandler:     createRetryHandler(),
+		savepointStorage: savepoint.NewStorage(),
 	}
 }
@@ -13,13 +13,16 @@ import (
 
 	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
 	"github.com/lyft/flinkk8soperator/pkg/controller/common"
+	"github.com/lyft/flinkk8soperator/pkg/controller/config"
 	"github.com/lyft/flinkk8soperator/pkg/controller/flink/mock"
 	k8mock "github.com/lyft/flinkk8soperator/pkg/controller/k8/mock"
 	mockScope "github.com/lyft/flytestdlib/promutils"
 	"github.com/lyft/flytestdlib/promutils/labeled"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/tools/record"
 )
 
 const testSavepointLocation = "location"
@@ -62,6 +65,62 @@ func TestHandleNewOrCreate(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestConditionsForNewApplication(t *testing.T) {
+	stateMachineForTest := getTestStateMachine()
+	app := &v1beta1.FlinkApplication{
+		Spec: v1beta1.FlinkApplicationSpec{},
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), app)
+	assert.Nil(t, err)
+
+	assert.Equal(t, v1beta1.FlinkApplicationClusterStarting, app.Status.Phase)
+	assert.Equal(t, v1.ConditionTrue, app.Status.GetCondition(v1beta1.ConditionProgressing).Status)
+	assert.Equal(t, "ClusterStarting", app.Status.GetCondition(v1beta1.ConditionProgressing).Reason)
+	assert.Equal(t, v1.ConditionFalse, app.Status.GetCondition(v1beta1.ConditionDegraded).Status)
+	assert.Equal(t, v1.ConditionFalse, app.Status.GetCondition(v1beta1.ConditionClusterReady).Status)
+	assert.Equal(t, v1.ConditionFalse, app.Status.GetCondition(v1beta1.ConditionJobRunning).Status)
+	assert.Nil(t, app.Status.GetCondition(v1beta1.ConditionSavepointSucceeded))
+}
+
+func TestConditionsForRunningApplication(t *testing.T) {
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetLatestJobIDFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) string {
+		return "job-id"
+	}
+	mockFlinkController.GetLatestClusterStatusFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) v1beta1.FlinkClusterStatus {
+		return v1beta1.FlinkClusterStatus{Health: v1beta1.Green}
+	}
+
+	app := &v1beta1.FlinkApplication{
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase: v1beta1.FlinkApplicationRunning,
+			LastSeenError: &v1beta1.FlinkApplicationError{
+				AppError: "error",
+			},
+		},
+	}
+	stateMachineForTest.updateConditions(context.Background(), app)
+
+	assert.Equal(t, v1.ConditionFalse, app.Status.GetCondition(v1beta1.ConditionProgressing).Status)
+	assert.Equal(t, v1.ConditionTrue, app.Status.GetCondition(v1beta1.ConditionDegraded).Status)
+	assert.Equal(t, "error", app.Status.GetCondition(v1beta1.ConditionDegraded).Message)
+	assert.Equal(t, v1.ConditionTrue, app.Status.GetCondition(v1beta1.ConditionClusterReady).Status)
+	// JobRunning is left to CompareAndUpdateJobStatus while there is a job
+	assert.Nil(t, app.Status.GetCondition(v1beta1.ConditionJobRunning))
+
+	transitionTime := app.Status.GetCondition(v1beta1.ConditionDegraded).LastTransitionTime
+	app.Status.LastSeenError = nil
+	app.Status.Phase = v1beta1.FlinkApplicationRollingBackJob
+	stateMachineForTest.updateConditions(context.Background(), app)
+
+	// the status has not changed, so the transition time is kept
+	assert.Equal(t, "RollingBack", app.Status.GetCondition(v1beta1.ConditionDegraded).Reason)
+	assert.Equal(t, transitionTime, app.Status.GetCondition(v1beta1.ConditionDegraded).LastTransitionTime)
+	assert.Equal(t, v1.ConditionTrue, app.Status.GetCondition(v1beta1.ConditionProgressing).Status)
+}
+
 func TestHandleStartingClusterStarting(t *testing.T) {
 	stateMachineForTest := getTestStateMachine()
 	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
@@ -284,6 +343,7 @@ func TestHandleApplicationSavepointingDual(t *testing.T) {
 		Status: v1beta1.FlinkApplicationStatus{
 			Phase:      v1beta1.FlinkApplicationSavepointing,
 			DeployHash: "old-hash",
+			JobStatus:  v1beta1.FlinkJobStatus{JobID: "old-job"},
 		},
 	}
 
@@ -319,6 +379,15 @@ func TestHandleApplicationSavepointingDual(t *testing.T) {
 		} else {
 			assert.Equal(t, testSavepointLocation, application.Status.SavepointPath)
 			assert.Equal(t, v1beta1.FlinkApplicationSubmittingJob, application.Status.Phase)
+			condition := application.Status.GetCondition(v1beta1.ConditionSavepointSucceeded)
+			assert.Equal(t, v1.ConditionTrue, condition.Status)
+			assert.Equal(t, testSavepointLocation, condition.Message)
+			assert.Equal(t, []v1beta1.SavepointRecord{{
+				Path:   testSavepointLocation,
+				Reason: v1beta1.SavepointReasonUpdate,
+				JobID:  "old-job",
+				Hash:   "old-hash",
+			}}, application.Status.SavepointHistory)
 		}
 
 		updateCount++
@@ -362,6 +431,7 @@ func TestHandleApplicationSavepointingFailed(t *testing.T) {
 		application := object.(*v1beta1.FlinkApplication)
 		assert.Empty(t, application.Status.SavepointPath)
 		assert.Equal(t, v1beta1.FlinkApplicationRecovering, application.Status.Phase)
+		assert.Equal(t, v1.ConditionFalse, application.Status.GetCondition(v1beta1.ConditionSavepointSucceeded).Status)
 		updateInvoked = true
 		return nil
 	}
@@ -493,93 +563,1722 @@ func TestSubmittingToRunning(t *testing.T) {
 		}, nil
 	}
 
-	updateCount := 0
-	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
-		if updateCount == 0 {
-			// update to the service
-			service := object.(*v1.Service)
-			assert.Equal(t, appHash, service.Spec.Selector["flink-app-hash"])
-		} else if updateCount == 1 {
-			application := object.(*v1beta1.FlinkApplication)
-			assert.Equal(t, jobFinalizer, application.Finalizers[0])
-		}
+	updateCount := 0
+	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		if updateCount == 0 {
+			// update to the service
+			service := object.(*v1.Service)
+			assert.Equal(t, appHash, service.Spec.Selector["flink-app-hash"])
+		} else if updateCount == 1 {
+			application := object.(*v1beta1.FlinkApplication)
+			assert.Equal(t, jobFinalizer, application.Finalizers[0])
+		}
+
+		updateCount++
+		return nil
+	}
+
+	statusUpdateCount := 0
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		if statusUpdateCount == 0 {
+			application := object.(*v1beta1.FlinkApplication)
+			assert.Equal(t, jobID, mockFlinkController.GetLatestJobID(ctx, application))
+		} else if statusUpdateCount == 1 {
+			application := object.(*v1beta1.FlinkApplication)
+			assert.Equal(t, appHash, application.Status.DeployHash)
+			jobStatus := mockFlinkController.GetLatestJobStatus(ctx, application)
+			assert.Equal(t, app.Spec.JarName, jobStatus.JarName)
+			assert.Equal(t, app.Spec.Parallelism, jobStatus.Parallelism)
+			assert.Equal(t, app.Spec.EntryClass, jobStatus.EntryClass)
+			assert.Equal(t, app.Spec.ProgramArgs, jobStatus.ProgramArgs)
+			assert.Equal(t, v1beta1.FlinkApplicationRunning, application.Status.Phase)
+		}
+		statusUpdateCount++
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, startCount)
+	assert.Equal(t, 2, updateCount)
+	assert.Equal(t, 2, statusUpdateCount)
+}
+
+func TestSubmittingUploadedJar(t *testing.T) {
+	jobID := "j1"
+	jarID := "5f3b1c2a_job.jar"
+
+	app := v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			JarURI:      "s3://artifacts/job.jar",
+			JarChecksum: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			Parallelism: 5,
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      v1beta1.FlinkApplicationSubmittingJob,
+			DeployHash: "old-hash",
+			JobStatus: v1beta1.FlinkJobStatus{
+				JarName: "old-jar-id",
+			},
+		},
+	}
+	appHash := flink.HashForApplication(&app)
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	uploadErr := errors.New("checksum mismatch")
+	uploadCount := 0
+	mockFlinkController.UploadJarFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+		jarURI string, checksum string) (string, error) {
+		uploadCount++
+		assert.Equal(t, appHash, hash)
+		assert.Equal(t, app.Spec.JarURI, jarURI)
+		assert.Equal(t, app.Spec.JarChecksum, checksum)
+		return jarID, uploadErr
+	}
+	mockFlinkController.StartFlinkJobFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+		jarName string, parallelism int32, entryClass string, programArgs string, allowNonRestoredState bool, savepointPath string) (string, error) {
+		assert.Equal(t, jarID, jarName)
+		return jobID, nil
+	}
+	mockFlinkController.GetJobForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (*client.FlinkJobOverview, error) {
+		return &client.FlinkJobOverview{
+			JobID: jobID,
+			State: client.Running,
+		}, nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetServiceFunc = func(ctx context.Context, namespace string, name string, version string) (*v1.Service, error) {
+		return &v1.Service{
+			Spec: v1.ServiceSpec{
+				Selector: map[string]string{
+					"flink-app-hash": appHash,
+				},
+			},
+		}, nil
+	}
+
+	// a failed upload is retried without submitting the job
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.NotNil(t, err)
+	assert.Equal(t, "", app.Status.JobStatus.JobID)
+	assert.Equal(t, "JarUploadFailed", mockFlinkController.Events[0].Reason)
+
+	// the id of the uploaded jar is saved before the job is submitted with it
+	uploadErr = nil
+	app.Status.LastSeenError = nil
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, "", app.Status.JobStatus.JobID)
+	assert.Equal(t, jarID, app.Status.JarID)
+	assert.Equal(t, appHash, app.Status.JarHash)
+
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, jobID, app.Status.JobStatus.JobID)
+	assert.Equal(t, 2, uploadCount)
+	// the previous jar is kept until the new job is running, in case the deploy is rolled back
+	assert.Equal(t, "old-jar-id", app.Status.JobStatus.JarName)
+
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, jarID, app.Status.JobStatus.JarName)
+	assert.Equal(t, 2, uploadCount)
+}
+
+func TestSubmittingUploadedJarWithActiveJob(t *testing.T) {
+	app := v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			JarURI:      "s3://artifacts/job.jar",
+			Parallelism: 5,
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      v1beta1.FlinkApplicationSubmittingJob,
+			DeployHash: "old-hash",
+		},
+	}
+	appHash := flink.HashForApplication(&app)
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.UploadJarFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+		jarURI string, checksum string) (string, error) {
+		assert.False(t, true)
+		return "", nil
+	}
+	mockFlinkController.StartFlinkJobFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+		jarName string, parallelism int32, entryClass string, programArgs string, allowNonRestoredState bool, savepointPath string) (string, error) {
+		assert.False(t, true)
+		return "", nil
+	}
+	mockFlinkController.GetJobsForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) ([]client.FlinkJob, error) {
+		return []client.FlinkJob{{JobID: "j1", Status: client.Running}}, nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetServiceFunc = func(ctx context.Context, namespace string, name string, version string) (*v1.Service, error) {
+		return &v1.Service{
+			Spec: v1.ServiceSpec{
+				Selector: map[string]string{
+					"flink-app-hash": appHash,
+				},
+			},
+		}, nil
+	}
+
+	// a job submitted in a previous cycle is picked up without uploading the jar again
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, "j1", app.Status.JobStatus.JobID)
+}
+
+func TestApplicationModeUpdateStopsJobBeforeCreatingCluster(t *testing.T) {
+	app := v1beta1.FlinkApplication{
+		Spec: v1beta1.FlinkApplicationSpec{
+			ExecutionMode: v1beta1.ExecutionModeApplication,
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      v1beta1.FlinkApplicationUpdating,
+			DeployHash: "old-hash",
+			JobStatus: v1beta1.FlinkJobStatus{
+				JobID: "old-job",
+			},
+		},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.CreateClusterFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) error {
+		// the cluster must not be created while the old job is still running
+		assert.False(t, true)
+		return nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	updateInvoked := false
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, v1beta1.FlinkApplicationSavepointing, application.Status.Phase)
+		updateInvoked = true
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updateInvoked)
+}
+
+func TestApplicationModeWithBlueGreenDeployFails(t *testing.T) {
+	app := v1beta1.FlinkApplication{
+		Spec: v1beta1.FlinkApplicationSpec{
+			ExecutionMode:  v1beta1.ExecutionModeApplication,
+			DeploymentMode: v1beta1.DeploymentModeBlueGreen,
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      v1beta1.FlinkApplicationUpdating,
+			DeployHash: "old-hash",
+			JobStatus: v1beta1.FlinkJobStatus{
+				JobID: "old-job",
+			},
+		},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.CreateClusterFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) error {
+		assert.False(t, true)
+		return nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	updateInvoked := false
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, v1beta1.FlinkApplicationDeployFailed, application.Status.Phase)
+		assert.Equal(t, flink.HashForApplication(application), application.Status.FailedDeployHash)
+		updateInvoked = true
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updateInvoked)
+	assert.Equal(t, 1, len(mockFlinkController.Events))
+	assert.Equal(t, "UnsupportedDeploymentMode", mockFlinkController.Events[0].Reason)
+}
+
+func TestApplicationModeSavepointingToUpdating(t *testing.T) {
+	app := v1beta1.FlinkApplication{
+		Spec: v1beta1.FlinkApplicationSpec{
+			ExecutionMode: v1beta1.ExecutionModeApplication,
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:              v1beta1.FlinkApplicationSavepointing,
+			DeployHash:         "old-hash",
+			SavepointTriggerID: "trigger",
+			JobStatus: v1beta1.FlinkJobStatus{
+				JobID: "old-job",
+			},
+		},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetSavepointStatusFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) (*client.SavepointResponse, error) {
+		return &client.SavepointResponse{
+			SavepointStatus: client.SavepointStatusResponse{
+				Status: client.SavePointCompleted,
+			},
+			Operation: client.SavepointOperationResponse{
+				Location: testSavepointLocation,
+			},
+		}, nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	updateInvoked := false
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, v1beta1.FlinkApplicationUpdating, application.Status.Phase)
+		assert.Equal(t, testSavepointLocation, application.Status.SavepointPath)
+		assert.Equal(t, "", application.Status.JobStatus.JobID)
+		updateInvoked = true
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updateInvoked)
+
+	// with the job stopped, the new cluster can now be created
+	createInvoked := false
+	mockFlinkController.CreateClusterFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) error {
+		assert.Equal(t, testSavepointLocation, flink.GetSavepointPathForDeploy(application))
+		createInvoked = true
+		return nil
+	}
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, v1beta1.FlinkApplicationClusterStarting, application.Status.Phase)
+		return nil
+	}
+
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, createInvoked)
+}
+
+func TestApplicationModeClusterStartingToRunning(t *testing.T) {
+	jobID := "j1"
+	app := v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			ExecutionMode: v1beta1.ExecutionModeApplication,
+			Parallelism:   5,
+			EntryClass:    "com.my.Class",
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      v1beta1.FlinkApplicationClusterStarting,
+			DeployHash: "old-hash",
+		},
+	}
+	appHash := flink.HashForApplication(&app)
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.IsClusterReadyFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (bool, error) {
+		return true, nil
+	}
+	mockFlinkController.IsServiceReadyFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (bool, error) {
+		return true, nil
+	}
+	mockFlinkController.StartFlinkJobFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+		jarName string, parallelism int32, entryClass string, programArgs string, allowNonRestoredState bool, savepointPath string) (string, error) {
+		// the job is started by the job manager, not submitted by the operator
+		assert.False(t, true)
+		return "", nil
+	}
+	mockFlinkController.GetJobsForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) ([]client.FlinkJob, error) {
+		assert.Equal(t, appHash, hash)
+		return []client.FlinkJob{{JobID: jobID, Status: client.Running}}, nil
+	}
+	mockFlinkController.GetJobForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (*client.FlinkJobOverview, error) {
+		assert.Equal(t, appHash, hash)
+		return &client.FlinkJobOverview{
+			JobID: jobID,
+			State: client.Running,
+		}, nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetServiceFunc = func(ctx context.Context, namespace string, name string, version string) (*v1.Service, error) {
+		return &v1.Service{
+			Spec: v1.ServiceSpec{
+				Selector: map[string]string{
+					"flink-app-hash": appHash,
+				},
+			},
+		}, nil
+	}
+
+	statusUpdateCount := 0
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		if statusUpdateCount == 0 {
+			assert.Equal(t, jobID, application.Status.JobStatus.JobID)
+			assert.Equal(t, v1beta1.FlinkApplicationClusterStarting, application.Status.Phase)
+		} else {
+			assert.Equal(t, appHash, application.Status.DeployHash)
+			assert.Equal(t, v1beta1.FlinkApplicationRunning, application.Status.Phase)
+		}
+		statusUpdateCount++
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, statusUpdateCount)
+}
+
+func TestApplicationModeRollingBack(t *testing.T) {
+	app := v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			ExecutionMode: v1beta1.ExecutionModeApplication,
+			Parallelism:   5,
+			EntryClass:    "com.my.Class",
+			ForceRollback: true,
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:         v1beta1.FlinkApplicationClusterStarting,
+			DeployHash:    "old-hash",
+			SavepointPath: "s3://savepoints/1",
+			JobStatus:     v1beta1.FlinkJobStatus{JobID: "j2"},
+		},
+	}
+	appHash := flink.HashForApplication(&app)
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	cancelled := false
+	mockFlinkController.ForceCancelFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) error {
+		assert.Equal(t, appHash, hash)
+		assert.Equal(t, "j2", jobID)
+		cancelled = true
+		return nil
+	}
+
+	// the job of the failed cluster is cancelled, and the previous job is started again
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, cancelled)
+	assert.Equal(t, "", app.Status.JobStatus.JobID)
+	assert.Equal(t, v1beta1.FlinkApplicationRollingBackJob, app.Status.Phase)
+
+	mockFlinkController.IsServiceReadyFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (bool, error) {
+		assert.Equal(t, "old-hash", hash)
+		return true, nil
+	}
+	jobs := []client.FlinkJob{{JobID: "j1", Status: client.Finished}}
+	mockFlinkController.GetJobsForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) ([]client.FlinkJob, error) {
+		assert.Equal(t, "old-hash", hash)
+		return jobs, nil
+	}
+	restartCount := 0
+	mockFlinkController.RestartJobManagerFromSavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, savepointPath string) (bool, error) {
+		assert.Equal(t, "old-hash", hash)
+		assert.Equal(t, "s3://savepoints/1", savepointPath)
+		restartCount++
+		return true, nil
+	}
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetServiceFunc = func(ctx context.Context, namespace string, name string, version string) (*v1.Service, error) {
+		return &v1.Service{
+			Spec: v1.ServiceSpec{
+				Selector: map[string]string{
+					"flink-app-hash": "old-hash",
+				},
+			},
+		}, nil
+	}
+
+	// the previous job manager still reports the stopped job
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, restartCount)
+	assert.Equal(t, v1beta1.FlinkApplicationRollingBackJob, app.Status.Phase)
+
+	// the restarted job manager runs the job from the savepoint
+	jobs = []client.FlinkJob{{JobID: "j3", Status: client.Running}}
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, restartCount)
+	assert.Equal(t, v1beta1.FlinkApplicationDeployFailed, app.Status.Phase)
+	assert.Equal(t, "j3", app.Status.JobStatus.JobID)
+	assert.Equal(t, "", app.Status.SavepointPath)
+	assert.Equal(t, appHash, app.Status.FailedDeployHash)
+	assert.Equal(t, "old-hash", app.Status.DeployHash)
+}
+
+func TestHandleApplicationRunning(t *testing.T) {
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		assert.True(t, false)
+		return nil
+	}
+	err := stateMachineForTest.Handle(context.Background(), &v1beta1.FlinkApplication{
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase: v1beta1.FlinkApplicationRunning,
+		},
+	})
+	assert.Nil(t, err)
+}
+
+func TestRunningToClusterStarting(t *testing.T) {
+	updateInvoked := false
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		return nil, nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, v1beta1.FlinkApplicationUpdating, application.Status.Phase)
+		updateInvoked = true
+		return nil
+	}
+	err := stateMachineForTest.Handle(context.Background(), &v1beta1.FlinkApplication{
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase: v1beta1.FlinkApplicationRunning,
+		},
+	})
+	assert.True(t, updateInvoked)
+	assert.Nil(t, err)
+}
+
+func TestRunningWithUnresolvableRestoreFrom(t *testing.T) {
+	updateInvoked := false
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		return nil, nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, v1beta1.FlinkApplicationDeployFailed, application.Status.Phase)
+		updateInvoked = true
+		return nil
+	}
+
+	index := int32(1)
+	err := stateMachineForTest.Handle(context.Background(), &v1beta1.FlinkApplication{
+		Spec: v1beta1.FlinkApplicationSpec{
+			RestoreFrom: &v1beta1.RestoreFrom{Index: &index},
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase: v1beta1.FlinkApplicationRunning,
+			SavepointHistory: []v1beta1.SavepointRecord{
+				{Path: testSavepointLocation, Reason: v1beta1.SavepointReasonScheduled},
+			},
+		},
+	})
+	assert.True(t, updateInvoked)
+	assert.Nil(t, err)
+}
+
+func TestRestoreFromAppliesToSingleDeploy(t *testing.T) {
+	index := int32(0)
+	app := v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			Parallelism: 5,
+			RestoreFrom: &v1beta1.RestoreFrom{Index: &index},
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      v1beta1.FlinkApplicationRunning,
+			DeployHash: "old-hash",
+			SavepointHistory: []v1beta1.SavepointRecord{
+				{Path: "s3://savepoints/1", Reason: v1beta1.SavepointReasonScheduled, Hash: "old-hash"},
+				{Path: "s3://savepoints/2", Reason: v1beta1.SavepointReasonScheduled, Hash: "old-hash"},
+			},
+		},
+	}
+	appHash := flink.HashForApplication(&app)
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		return nil, nil
+	}
+	startCount := 0
+	mockFlinkController.StartFlinkJobFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+		jarName string, parallelism int32, entryClass string, programArgs string, allowNonRestoredState bool, savepointPath string) (string, error) {
+		startCount++
+		assert.Equal(t, "s3://savepoints/2", savepointPath)
+		return "j1", nil
+	}
+	mockFlinkController.GetJobForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (*client.FlinkJobOverview, error) {
+		return &client.FlinkJobOverview{
+			JobID: "j1",
+			State: client.Running,
+		}, nil
+	}
+
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.GetServiceFunc = func(ctx context.Context, namespace string, name string, version string) (*v1.Service, error) {
+		return &v1.Service{
+			Spec: v1.ServiceSpec{
+				Selector: map[string]string{
+					"flink-app-hash": appHash,
+				},
+			},
+		}, nil
+	}
+
+	// the savepoint is resolved when the deploy starts
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationUpdating, app.Status.Phase)
+	assert.Equal(t, "s3://savepoints/2", app.Status.RestoreFrom.Path)
+	assert.False(t, app.Status.RestoreFrom.Restored)
+
+	// the savepoint taken of the running job during the deploy does not change it
+	app.Status.SavepointHistory = append(app.Status.SavepointHistory,
+		v1beta1.SavepointRecord{Path: "s3://savepoints/3", Reason: v1beta1.SavepointReasonUpdate, Hash: "old-hash"})
+	app.Status.SavepointPath = "s3://savepoints/3"
+	app.Status.Phase = v1beta1.FlinkApplicationSubmittingJob
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, 1, startCount)
+	assert.True(t, app.Status.RestoreFrom.Restored)
+
+	// later deploys start from the savepoint of the running job
+	app.Status.SavepointPath = "s3://savepoints/4"
+	assert.Equal(t, "s3://savepoints/4", flink.GetSavepointPathForDeploy(&app))
+}
+
+func TestRunningToUpdatingWithAutoscaler(t *testing.T) {
+	target := 0.5
+	startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	app := v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			Parallelism: 8,
+			Autoscaler: &v1beta1.AutoscalerConfig{
+				MinParallelism:    2,
+				MaxParallelism:    32,
+				TargetUtilization: &target,
+				Cooldown:          &metav1.Duration{Duration: 10 * time.Minute},
+			},
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase: v1beta1.FlinkApplicationRunning,
+			JobStatus: v1beta1.FlinkJobStatus{
+				JobID:     "j1",
+				State:     v1beta1.Running,
+				StartTime: &startTime,
+			},
+		},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(time.Now())
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	mockFlinkController.GetAutoscalerUtilizationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (v1beta1.AutoscalerMetric, float64, error) {
+		return v1beta1.AutoscalerMetricBusyTime, 0.95, nil
+	}
+
+	updateInvoked := false
+	objectUpdated := false
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		// the chosen parallelism is pinned in the resource, so the hash does not depend on the status
+		assert.Equal(t, `{"parallelism":16,"specParallelism":8}`, application.Annotations[flink.AutoscaledParallelism])
+		assert.Equal(t, int32(16), flink.GetParallelism(application))
+		objectUpdated = true
+		return nil
+	}
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, v1beta1.FlinkApplicationUpdating, application.Status.Phase)
+		assert.Equal(t, int32(16), application.Status.Autoscaler.Parallelism)
+		assert.Equal(t, int32(8), application.Status.Autoscaler.SpecParallelism)
+		assert.Equal(t, 1, len(application.Status.Autoscaler.History))
+		assert.Equal(t, int32(8), application.Status.Autoscaler.History[0].FromParallelism)
+		assert.Equal(t, int32(16), application.Status.Autoscaler.History[0].ToParallelism)
+		updateInvoked = true
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, objectUpdated)
+	assert.True(t, updateInvoked)
+}
+
+func TestAutoscalerNotPinnedWhenUpdateFails(t *testing.T) {
+	app := getAutoscaledTestApp()
+	startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	app.Status.Phase = v1beta1.FlinkApplicationRunning
+	app.Status.JobStatus = v1beta1.FlinkJobStatus{State: v1beta1.Running, StartTime: &startTime}
+	app.Status.Autoscaler.LastScaleTime = &startTime
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(time.Now())
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetAutoscalerUtilizationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (v1beta1.AutoscalerMetric, float64, error) {
+		return v1beta1.AutoscalerMetricBusyTime, 0.95, nil
+	}
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		return errors.New("conflict")
+	}
+
+	assert.False(t, stateMachineForTest.autoscale(context.Background(), &app))
+	assert.Equal(t, int32(16), flink.GetParallelism(&app))
+	assert.Equal(t, 1, len(app.Status.Autoscaler.History))
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+}
+
+func TestAutoscalerCooldown(t *testing.T) {
+	now := time.Now()
+	startTime := metav1.NewTime(now.Add(-time.Hour))
+	lastScaleTime := metav1.NewTime(now.Add(-time.Minute))
+	app := v1beta1.FlinkApplication{
+		Spec: v1beta1.FlinkApplicationSpec{
+			Parallelism: 8,
+			Autoscaler: &v1beta1.AutoscalerConfig{
+				MinParallelism: 2,
+				MaxParallelism: 32,
+				Cooldown:       &metav1.Duration{Duration: 10 * time.Minute},
+			},
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase: v1beta1.FlinkApplicationRunning,
+			JobStatus: v1beta1.FlinkJobStatus{
+				State:     v1beta1.Running,
+				StartTime: &startTime,
+			},
+			Autoscaler: &v1beta1.AutoscalerStatus{
+				Parallelism:   16,
+				LastScaleTime: &lastScaleTime,
+			},
+		},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+	assert.False(t, stateMachineForTest.shouldAutoscale(&app))
+
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now.Add(10 * time.Minute))
+	assert.True(t, stateMachineForTest.shouldAutoscale(&app))
+
+	// jobs are not rescaled while they are restarting
+	app.Status.JobStatus.State = v1beta1.Failing
+	assert.False(t, stateMachineForTest.shouldAutoscale(&app))
+}
+
+func getAutoscaledTestApp() v1beta1.FlinkApplication {
+	now := metav1.Now()
+	app := v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			Parallelism: 8,
+			Autoscaler: &v1beta1.AutoscalerConfig{
+				MinParallelism: 2,
+				MaxParallelism: 32,
+				Cooldown:       &metav1.Duration{Duration: 10 * time.Minute},
+			},
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Autoscaler: &v1beta1.AutoscalerStatus{
+				Parallelism:     16,
+				SpecParallelism: 8,
+				LastScaleTime:   &now,
+				History: []v1beta1.AutoscalerDecision{
+					{Time: now, FromParallelism: 8, ToParallelism: 16, Metric: v1beta1.AutoscalerMetricBusyTime},
+				},
+			},
+		},
+	}
+	flink.SetAutoscaledParallelism(&app, 16)
+	return app
+}
+
+func TestUpdatingDiscardsAutoscaledParallelism(t *testing.T) {
+	app := getAutoscaledTestApp()
+	app.Spec.Parallelism = 4
+	app.Status.Phase = v1beta1.FlinkApplicationUpdating
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.CreateClusterFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) error {
+		assert.Equal(t, int32(4), flink.GetParallelism(application))
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationClusterStarting, app.Status.Phase)
+	assert.Equal(t, int32(0), app.Status.Autoscaler.Parallelism)
+	_, ok := app.Annotations[flink.AutoscaledParallelism]
+	assert.False(t, ok)
+	// the history of decisions is kept
+	assert.Equal(t, 1, len(app.Status.Autoscaler.History))
+}
+
+func TestDeployFailedRevertsAutoscaling(t *testing.T) {
+	app := getAutoscaledTestApp()
+	deployed := app.DeepCopy()
+	flink.SetAutoscaledParallelism(deployed, 0)
+	app.Status.DeployHash = flink.HashForApplication(deployed)
+	failedHash := flink.HashForApplication(&app)
+
+	stateMachineForTest := getTestStateMachine()
+	updated := false
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, int32(8), flink.GetParallelism(application))
+		updated = true
+		return nil
+	}
+
+	_, err := stateMachineForTest.deployFailed(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, v1beta1.FlinkApplicationDeployFailed, app.Status.Phase)
+	assert.Equal(t, failedHash, app.Status.FailedDeployHash)
+	assert.Equal(t, int32(8), app.Status.Autoscaler.Parallelism)
+	assert.Equal(t, app.Status.DeployHash, flink.HashForApplication(&app))
+
+	// once the job of the previous version is running again, the autoscaler picks up from DeployFailed
+	startTime := metav1.NewTime(time.Now())
+	app.Status.JobStatus = v1beta1.FlinkJobStatus{State: v1beta1.Running, StartTime: &startTime}
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(startTime.Add(10 * time.Minute))
+	assert.True(t, stateMachineForTest.shouldAutoscale(&app))
+}
+
+func TestDeployFailedKeepsUnrelatedAutoscaling(t *testing.T) {
+	app := getAutoscaledTestApp()
+	app.Status.DeployHash = flink.HashForApplication(&app)
+	// the failed deploy changed the image, not the parallelism
+	app.Spec.Image = "flink:new"
+
+	stateMachineForTest := getTestStateMachine()
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		assert.False(t, true)
+		return nil
+	}
+	_, err := stateMachineForTest.deployFailed(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(16), app.Status.Autoscaler.Parallelism)
+	assert.Equal(t, int32(16), flink.GetParallelism(&app))
+}
+
+type fakeSavepointStorage struct {
+	deleted []string
+	err     error
+}
+
+func (f *fakeSavepointStorage) Delete(ctx context.Context, location string) error {
+	f.deleted = append(f.deleted, location)
+	return f.err
+}
+
+func getSavepointScheduleTestApp(now time.Time) v1beta1.FlinkApplication {
+	startTime := metav1.NewTime(now.Add(-2 * time.Hour))
+	retainCount := int32(2)
+	return v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			SavepointSchedule: &v1beta1.SavepointSchedule{
+				Interval:      &metav1.Duration{Duration: time.Hour},
+				RetainCount:   &retainCount,
+				DeleteExpired: true,
+			},
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      v1beta1.FlinkApplicationRunning,
+			DeployHash: "hash",
+			JobStatus: v1beta1.FlinkJobStatus{
+				JobID:     "j1",
+				State:     v1beta1.Running,
+				StartTime: &startTime,
+			},
+		},
+	}
+}
+
+func TestRunningUpdatesPodDisruptionBudget(t *testing.T) {
+	app := getSavepointScheduleTestApp(time.Now())
+	app.Spec.SavepointSchedule = nil
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	var updatedHash string
+	mockFlinkController.UpdatePodDisruptionBudgetFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (bool, error) {
+		updatedHash = hash
+		return false, nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, "hash", updatedHash)
+}
+
+func TestRunningWithScheduledSavepoint(t *testing.T) {
+	now := time.Now()
+	app := getSavepointScheduleTestApp(now)
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	mockFlinkController.SavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error) {
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, "j1", jobID)
+		assert.False(t, isCancel)
+		return "t1", nil
+	}
+
+	updateInvoked := false
+	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
+	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
+		application := object.(*v1beta1.FlinkApplication)
+		assert.Equal(t, v1beta1.FlinkApplicationRunning, application.Status.Phase)
+		status := application.Status.SavepointSchedule
+		assert.Equal(t, now.Unix(), status.LastTriggerTime.Unix())
+		assert.Equal(t, 1, len(status.Savepoints))
+		assert.Equal(t, "t1", status.Savepoints[0].TriggerID)
+		assert.Equal(t, "j1", status.Savepoints[0].JobID)
+		assert.Equal(t, v1beta1.SavepointInProgress, status.Savepoints[0].State)
+		updateInvoked = true
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updateInvoked)
+
+	// the next savepoint is only taken once the first has completed and the interval has passed
+	mockFlinkController.SavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error) {
+		assert.False(t, true)
+		return "", nil
+	}
+	mockFlinkController.GetSavepointStatusForTriggerFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error) {
+		assert.Equal(t, "t1", triggerID)
+		return &client.SavepointResponse{
+			SavepointStatus: client.SavepointStatusResponse{
+				Status: client.SavePointInProgress,
+			},
+		}, nil
+	}
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now.Add(2 * time.Hour))
+	updated, err := stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.False(t, updated)
+}
+
+func TestScheduledSavepointCompletion(t *testing.T) {
+	now := time.Now()
+	app := getSavepointScheduleTestApp(now)
+	lastTriggerTime := metav1.NewTime(now.Add(-time.Minute))
+	app.Status.SavepointSchedule = &v1beta1.SavepointScheduleStatus{
+		LastTriggerTime: &lastTriggerTime,
+		Savepoints: []v1beta1.ScheduledSavepoint{
+			{
+				TriggerID:   "t1",
+				JobID:       "j1",
+				TriggerTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+				State:       v1beta1.SavepointSucceeded,
+				Location:    "file:///savepoints/savepoint-1",
+			},
+			{
+				TriggerID:   "t2",
+				JobID:       "j1",
+				TriggerTime: metav1.NewTime(now.Add(-time.Hour)),
+				State:       v1beta1.SavepointSucceeded,
+				Location:    "file:///savepoints/savepoint-2",
+			},
+			{
+				TriggerID:   "t3",
+				JobID:       "j1",
+				TriggerTime: lastTriggerTime,
+				State:       v1beta1.SavepointInProgress,
+			},
+		},
+	}
+	app.Status.SavepointHistory = []v1beta1.SavepointRecord{
+		{Path: "file:///savepoints/savepoint-1", Reason: v1beta1.SavepointReasonScheduled, JobID: "j1", Hash: "hash"},
+		{Path: "file:///savepoints/savepoint-2", Reason: v1beta1.SavepointReasonScheduled, JobID: "j1", Hash: "hash"},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+	storage := &fakeSavepointStorage{}
+	stateMachineForTest.savepointStorage = storage
+
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	mockFlinkController.GetSavepointStatusForTriggerFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error) {
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, "j1", jobID)
+		assert.Equal(t, "t3", triggerID)
+		return &client.SavepointResponse{
+			SavepointStatus: client.SavepointStatusResponse{
+				Status: client.SavePointCompleted,
+			},
+			Operation: client.SavepointOperationResponse{
+				Location: "file:///savepoints/savepoint-3",
+			},
+		}, nil
+	}
+
+	updated, err := stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+
+	// the oldest savepoint has expired
+	assert.Equal(t, []string{"file:///savepoints/savepoint-1"}, storage.deleted)
+	savepoints := app.Status.SavepointSchedule.Savepoints
+	assert.Equal(t, 2, len(savepoints))
+	assert.Equal(t, "t2", savepoints[0].TriggerID)
+	assert.Equal(t, "t3", savepoints[1].TriggerID)
+	assert.Equal(t, v1beta1.SavepointSucceeded, savepoints[1].State)
+	assert.Equal(t, "file:///savepoints/savepoint-3", savepoints[1].Location)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+
+	// the deleted savepoint can no longer be restored from
+	history := app.Status.SavepointHistory
+	assert.Equal(t, 2, len(history))
+	assert.Equal(t, "file:///savepoints/savepoint-2", history[0].Path)
+	assert.Equal(t, "file:///savepoints/savepoint-3", history[1].Path)
+	assert.Equal(t, v1beta1.SavepointReasonScheduled, history[1].Reason)
+}
+
+func TestScheduledSavepointDeletionFailure(t *testing.T) {
+	now := time.Now()
+	app := getSavepointScheduleTestApp(now)
+	lastTriggerTime := metav1.NewTime(now.Add(-time.Minute))
+	app.Status.SavepointSchedule = &v1beta1.SavepointScheduleStatus{
+		LastTriggerTime: &lastTriggerTime,
+		Savepoints: []v1beta1.ScheduledSavepoint{
+			{
+				TriggerID:   "t1",
+				JobID:       "j1",
+				TriggerTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+				State:       v1beta1.SavepointSucceeded,
+				Location:    "file:///savepoints/savepoint-1",
+			},
+			{
+				TriggerID:   "t2",
+				JobID:       "j1",
+				TriggerTime: metav1.NewTime(now.Add(-time.Hour)),
+				State:       v1beta1.SavepointSucceeded,
+				Location:    "file:///savepoints/savepoint-2",
+			},
+			{
+				TriggerID:   "t3",
+				JobID:       "j1",
+				TriggerTime: lastTriggerTime,
+				State:       v1beta1.SavepointSucceeded,
+				Location:    "file:///savepoints/savepoint-3",
+			},
+		},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+	storage := &fakeSavepointStorage{err: errors.New("permission denied")}
+	stateMachineForTest.savepointStorage = storage
+
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+
+	updated, err := stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, []string{"file:///savepoints/savepoint-1"}, storage.deleted)
+	assert.Equal(t, 1, len(mockFlinkController.Events))
+	assert.Equal(t, "SavepointDeletionFailed", mockFlinkController.Events[0].Reason)
+	savepoints := app.Status.SavepointSchedule.Savepoints
+	assert.Equal(t, 3, len(savepoints))
+	assert.Equal(t, "permission denied", savepoints[0].DeletionFailure)
+
+	// the deletion is not retried
+	updated, err = stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.False(t, updated)
+	assert.Equal(t, 1, len(storage.deleted))
+	assert.Equal(t, 1, len(mockFlinkController.Events))
+}
+
+func TestScheduledSavepointOfReplacedJob(t *testing.T) {
+	now := time.Now()
+	app := getSavepointScheduleTestApp(now)
+	app.Spec.SavepointSchedule.Interval = &metav1.Duration{Duration: 4 * time.Hour}
+	app.Status.SavepointSchedule = &v1beta1.SavepointScheduleStatus{
+		Savepoints: []v1beta1.ScheduledSavepoint{
+			{
+				TriggerID:   "t1",
+				JobID:       "j0",
+				TriggerTime: metav1.NewTime(now.Add(-3 * time.Hour)),
+				State:       v1beta1.SavepointInProgress,
+			},
+		},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+	assert.True(t, stateMachineForTest.handleSavepointSchedule(context.Background(), &app))
+	savepoints := app.Status.SavepointSchedule.Savepoints
+	assert.Equal(t, 1, len(savepoints))
+	assert.Equal(t, v1beta1.SavepointFailed, savepoints[0].State)
+}
+
+func TestRunningWithSavepointNonce(t *testing.T) {
+	startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	app := v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			SavepointNonce: "1",
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      v1beta1.FlinkApplicationRunning,
+			DeployHash: "hash",
+			JobStatus: v1beta1.FlinkJobStatus{
+				JobID:     "j1",
+				State:     v1beta1.Running,
+				StartTime: &startTime,
+			},
+		},
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	savepointCount := 0
+	mockFlinkController.SavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error) {
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, "j1", jobID)
+		// the job is never cancelled
+		assert.False(t, isCancel)
+		savepointCount++
+		return []string{"t1", "t2"}[savepointCount-1], nil
+	}
+	mockFlinkController.ForceCancelFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) error {
+		assert.False(t, true)
+		return nil
+	}
+
+	updated, err := stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, 1, savepointCount)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, "1", app.Status.ManualSavepoint.Nonce)
+	assert.Equal(t, "t1", app.Status.ManualSavepoint.TriggerID)
+	assert.Equal(t, v1beta1.SavepointInProgress, app.Status.ManualSavepoint.State)
+
+	mockFlinkController.GetSavepointStatusForTriggerFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error) {
+		assert.Equal(t, "t1", triggerID)
+		return &client.SavepointResponse{
+			SavepointStatus: client.SavepointStatusResponse{
+				Status: client.SavePointCompleted,
+			},
+			Operation: client.SavepointOperationResponse{
+				Location: "s3://savepoints/savepoint-1",
+			},
+		}, nil
+	}
+
+	updated, err = stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, v1beta1.SavepointSucceeded, app.Status.ManualSavepoint.State)
+	assert.Equal(t, "s3://savepoints/savepoint-1", app.Status.ManualSavepoint.Location)
+	assert.NotNil(t, app.Status.ManualSavepoint.CompletionTime)
+	assert.Equal(t, v1.ConditionTrue, app.Status.GetCondition(v1beta1.ConditionSavepointSucceeded).Status)
+
+	// nothing happens until the nonce is changed again
+	updated, err = stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.False(t, updated)
+	assert.Equal(t, 1, savepointCount)
+
+	app.Spec.SavepointNonce = "2"
+	updated, err = stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, 2, savepointCount)
+	assert.Equal(t, "2", app.Status.ManualSavepoint.Nonce)
+	assert.Equal(t, "t2", app.Status.ManualSavepoint.TriggerID)
+	assert.Equal(t, "", app.Status.ManualSavepoint.Location)
+}
+
+func getAutoRollbackTestApp(now time.Time) v1beta1.FlinkApplication {
+	restarts := int32(1)
+	app := getSavepointScheduleTestApp(now)
+	app.Spec.SavepointSchedule = nil
+	app.Spec.AutoRollback = &v1beta1.AutoRollbackPolicy{
+		Window:         &metav1.Duration{Duration: 10 * time.Minute},
+		MaxJobRestarts: &restarts,
+	}
+	app.Status.ClusterStatus.HealthyTaskManagers = 1
+	app.Status.AutoRollback = &v1beta1.AutoRollbackStatus{
+		Hash:         "hash",
+		PreviousHash: "old-hash",
+		PreviousJob: v1beta1.FlinkJobStatus{
+			JobID:       "old-job",
+			JarName:     "old.jar",
+			Parallelism: 4,
+		},
+		SavepointPath: testSavepointLocation,
+		DeployTime:    metav1.NewTime(now.Add(-time.Minute)),
+	}
+	return app
+}
+
+func TestRunningWithAutoRollback(t *testing.T) {
+	now := time.Now()
+	app := getAutoRollbackTestApp(now)
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	mockFlinkController.DeleteOldResourcesForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) error {
+		// the previous version is kept while the window is open
+		assert.False(t, true)
+		return nil
+	}
+	forceCancelInvoked := false
+	mockFlinkController.ForceCancelFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) error {
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, "j1", jobID)
+		forceCancelInvoked = true
+		return nil
+	}
+
+	// the first observation is the baseline for the checks
+	updated, err := stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, int32(0), *app.Status.AutoRollback.InitialJobRestartCount)
+
+	app.Status.JobStatus.JobRestartCount = 2
+	updated, err = stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.True(t, updated)
+	assert.True(t, forceCancelInvoked)
+	assert.Equal(t, v1beta1.FlinkApplicationRollingBackJob, app.Status.Phase)
+	assert.Equal(t, "RestartRate", app.Status.AutoRollback.FailedCheck)
+	assert.Equal(t, "old-hash", app.Status.DeployHash)
+	assert.Equal(t, testSavepointLocation, app.Status.SavepointPath)
+	assert.Equal(t, "old.jar", app.Status.JobStatus.JarName)
+	assert.Equal(t, int32(4), app.Status.JobStatus.Parallelism)
+	// so that the previous job is submitted again
+	assert.Equal(t, "", app.Status.JobStatus.JobID)
+}
+
+func TestRunningAfterAutoRollbackWindow(t *testing.T) {
+	now := time.Now()
+	app := getAutoRollbackTestApp(now)
+	app.Status.JobStatus.JobRestartCount = 5
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now.Add(10 * time.Minute))
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	deleteInvoked := false
+	mockFlinkController.DeleteOldResourcesForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) error {
+		deleteInvoked = true
+		return nil
+	}
+	mockFlinkController.ForceCancelFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) error {
+		assert.False(t, true)
+		return nil
+	}
+
+	updated, err := stateMachineForTest.handleApplicationRunning(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.False(t, updated)
+	assert.True(t, deleteInvoked)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, "hash", app.Status.DeployHash)
+}
+
+func getSuspendTestSavepointStatus(status client.SavepointStatus, location string) *client.SavepointResponse {
+	return &client.SavepointResponse{
+		SavepointStatus: client.SavepointStatusResponse{
+			Status: status,
+		},
+		Operation: client.SavepointOperationResponse{
+			Location: location,
+		},
+	}
+}
+
+func TestSuspendAndResume(t *testing.T) {
+	app := getSavepointScheduleTestApp(time.Now())
+	app.Spec.SavepointSchedule = nil
+	app.Spec.DesiredState = v1beta1.DesiredStateSuspended
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.SavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error) {
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, "j1", jobID)
+		assert.True(t, isCancel)
+		return "t1", nil
+	}
+	mockFlinkController.GetSavepointStatusFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) (*client.SavepointResponse, error) {
+		return getSuspendTestSavepointStatus(client.SavePointInProgress, ""), nil
+	}
+	var deletedHash string
+	mockFlinkController.DeleteResourcesForAppWithHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) error {
+		deletedHash = hash
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationSuspending, app.Status.Phase)
+
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, "t1", app.Status.SavepointTriggerID)
+
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationSuspending, app.Status.Phase)
+	assert.Equal(t, "", app.Status.SavepointPath)
+
+	mockFlinkController.GetSavepointStatusFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) (*client.SavepointResponse, error) {
+		return getSuspendTestSavepointStatus(client.SavePointCompleted, testSavepointLocation), nil
+	}
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, testSavepointLocation, app.Status.SavepointPath)
+	assert.Equal(t, 1, len(app.Status.SavepointHistory))
+	assert.Equal(t, v1beta1.SavepointReasonSuspend, app.Status.SavepointHistory[0].Reason)
+	assert.Equal(t, "", deletedHash)
+
+	// the cluster is deleted once the job has been cancelled
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationSuspended, app.Status.Phase)
+	assert.Equal(t, "hash", deletedHash)
+	assert.Equal(t, "", app.Status.DeployHash)
+	assert.Equal(t, "", app.Status.JobStatus.JobID)
+
+	updated, err := stateMachineForTest.handleApplicationSuspended(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.False(t, updated)
+
+	// on resume a new cluster is created, and the job is started from the savepoint
+	app.Spec.DesiredState = v1beta1.DesiredStateRunning
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationUpdating, app.Status.Phase)
+	assert.Equal(t, testSavepointLocation, flink.GetSavepointPathForDeploy(&app))
+}
+
+func TestSuspendDualRunning(t *testing.T) {
+	app := getPromotionTestApp()
+	app.Spec.BlueGreen = nil
+	app.Spec.DesiredState = v1beta1.DesiredStateSuspended
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	var deletedHashes []string
+	mockFlinkController.DeleteResourcesForAppWithHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) error {
+		deletedHashes = append(deletedHashes, hash)
+		return nil
+	}
+	mockFlinkController.GetVersionAndJobIDForHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (string, string, error) {
+		return string(v1beta1.GreenFlinkApplication), "jobId", nil
+	}
+	mockFlinkController.SavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error) {
+		// the new version is the one that is suspended
+		assert.Equal(t, "updatingHash", hash)
+		assert.Equal(t, "jobId2", jobID)
+		assert.True(t, isCancel)
+		return "t1", nil
+	}
+	mockFlinkController.GetSavepointStatusFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) (*client.SavepointResponse, error) {
+		return getSuspendTestSavepointStatus(client.SavePointCompleted, testSavepointLocation), nil
+	}
+
+	// the old version is torn down first
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, []string{"deployHash"}, deletedHashes)
 
-		updateCount++
-		return nil
+	for i := 0; i < 4; i++ {
+		err = stateMachineForTest.Handle(context.Background(), &app)
+		assert.Nil(t, err)
 	}
+	assert.Equal(t, v1beta1.FlinkApplicationSuspended, app.Status.Phase)
+	assert.Equal(t, []string{"deployHash", "updatingHash"}, deletedHashes)
+	assert.Equal(t, testSavepointLocation, app.Status.SavepointPath)
+	assert.Equal(t, "", app.Status.DeployHash)
+	assert.Equal(t, v1beta1.FlinkApplicationVersion(""), app.Status.DeployVersion)
+	assert.Equal(t, 2, len(app.Status.VersionStatuses))
+	assert.Equal(t, "", app.Status.VersionStatuses[0].JobStatus.JobID)
+}
 
-	statusUpdateCount := 0
-	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
-		if statusUpdateCount == 0 {
-			application := object.(*v1beta1.FlinkApplication)
-			assert.Equal(t, jobID, mockFlinkController.GetLatestJobID(ctx, application))
-		} else if statusUpdateCount == 1 {
-			application := object.(*v1beta1.FlinkApplication)
-			assert.Equal(t, appHash, application.Status.DeployHash)
-			jobStatus := mockFlinkController.GetLatestJobStatus(ctx, application)
-			assert.Equal(t, app.Spec.JarName, jobStatus.JarName)
-			assert.Equal(t, app.Spec.Parallelism, jobStatus.Parallelism)
-			assert.Equal(t, app.Spec.EntryClass, jobStatus.EntryClass)
-			assert.Equal(t, app.Spec.ProgramArgs, jobStatus.ProgramArgs)
-			assert.Equal(t, v1beta1.FlinkApplicationRunning, application.Status.Phase)
-		}
-		statusUpdateCount++
+func getBoundedJobTestApp(now time.Time) v1beta1.FlinkApplication {
+	app := getSavepointScheduleTestApp(now)
+	app.Spec.SavepointSchedule = nil
+	app.Spec.BoundedJob = &v1beta1.BoundedJobConfig{
+		TTLAfterFinished: &metav1.Duration{Duration: 10 * time.Minute},
+	}
+	return app
+}
+
+func TestBoundedJobFinished(t *testing.T) {
+	now := time.Now()
+	app := getBoundedJobTestApp(now)
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	endTime := now.Add(-time.Minute)
+	mockFlinkController.GetJobForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (*client.FlinkJobOverview, error) {
+		return &client.FlinkJobOverview{
+			JobID:   "j1",
+			State:   client.Finished,
+			EndTime: endTime.UnixNano() / int64(time.Millisecond),
+		}, nil
+	}
+	mockFlinkController.CompareAndUpdateJobStatusFunc = func(ctx context.Context, app *v1beta1.FlinkApplication, hash string) (bool, error) {
+		app.Status.JobStatus.State = v1beta1.Finished
+		return true, nil
+	}
+	var deletedHash string
+	mockFlinkController.DeleteResourcesForAppWithHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) error {
+		deletedHash = hash
 		return nil
 	}
 
 	err := stateMachineForTest.Handle(context.Background(), &app)
 	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationSucceeded, app.Status.Phase)
+	assert.Equal(t, "hash", app.Status.FinishedJob.Hash)
+	assert.Equal(t, "j1", app.Status.FinishedJob.JobStatus.JobID)
+	assert.Equal(t, v1beta1.Finished, app.Status.FinishedJob.JobStatus.State)
+	assert.Equal(t, endTime.Unix(), app.Status.FinishedJob.FinishTime.Unix())
+
+	// the cluster is kept until the TTL has passed
 	err = stateMachineForTest.Handle(context.Background(), &app)
 	assert.Nil(t, err)
+	assert.Equal(t, "", deletedHash)
 
-	assert.Equal(t, 1, startCount)
-	assert.Equal(t, 2, updateCount)
-	assert.Equal(t, 2, statusUpdateCount)
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now.Add(10 * time.Minute))
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationSucceeded, app.Status.Phase)
+	assert.Equal(t, "hash", deletedHash)
+	assert.True(t, app.Status.FinishedJob.ClusterDeleted)
+	assert.Equal(t, "", app.Status.DeployHash)
+	assert.Equal(t, "", app.Status.JobStatus.JobID)
+
+	// the job is run again once the restart nonce changes
+	app.Spec.RestartNonce = "restart"
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationUpdating, app.Status.Phase)
+	assert.Nil(t, app.Status.FinishedJob)
 }
 
-func TestHandleApplicationRunning(t *testing.T) {
+func TestBoundedJobFailed(t *testing.T) {
+	now := time.Now()
+	app := getBoundedJobTestApp(now)
+
 	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
 	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
 	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
 		fd := testFlinkDeployment(application)
 		return &fd, nil
 	}
+	mockFlinkController.GetJobForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (*client.FlinkJobOverview, error) {
+		return &client.FlinkJobOverview{
+			JobID: "j1",
+			State: client.Failed,
+		}, nil
+	}
+	var deletedHash string
+	mockFlinkController.DeleteResourcesForAppWithHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) error {
+		deletedHash = hash
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationFailed, app.Status.Phase)
+	assert.Equal(t, now.Unix(), app.Status.FinishedJob.FinishTime.Unix())
+	degraded := app.Status.GetCondition(v1beta1.ConditionDegraded)
+	assert.Equal(t, v1.ConditionTrue, degraded.Status)
+	assert.Equal(t, "JobFailed", degraded.Reason)
+
+	// restarting before the TTL has passed deletes the cluster of the failed job first
+	app.Spec.RestartNonce = "restart"
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationUpdating, app.Status.Phase)
+	assert.Equal(t, "hash", deletedHash)
+	assert.Equal(t, "", app.Status.DeployHash)
+}
+
+func TestBoundedJobTTLDeletesDualCluster(t *testing.T) {
+	now := time.Now()
+	app := getBoundedJobTestApp(now)
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeDual
+	app.Status.Phase = v1beta1.FlinkApplicationSucceeded
+	app.Status.FinishedJob = &v1beta1.FinishedJobStatus{
+		Hash:       "hash",
+		FinishTime: metav1.NewTime(now.Add(-time.Hour)),
+	}
+
+	// the cluster is torn down by the actual controller, which has to find the unversioned Dual deployments
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+	stateMachineForTest.flinkController = flink.NewController(stateMachineForTest.k8Cluster, record.NewFakeRecorder(10),
+		config.RuntimeConfig{MetricsScope: mockScope.NewTestScope()})
 
+	jobManager := flink.FetchJobMangerDeploymentCreateObj(&app, "hash")
+	taskManager := flink.FetchTaskMangerDeploymentCreateObj(&app, "hash")
 	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
-	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
-		assert.True(t, false)
+	mockK8Cluster.GetDeploymentsWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*appsv1.DeploymentList, error) {
+		return &appsv1.DeploymentList{Items: []appsv1.Deployment{*jobManager, *taskManager}}, nil
+	}
+	mockK8Cluster.GetServicesWithLabelFunc = func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.ServiceList, error) {
+		return &v1.ServiceList{}, nil
+	}
+	var deleted []runtime.Object
+	mockK8Cluster.DeleteK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
+		deleted = append(deleted, object)
 		return nil
 	}
-	err := stateMachineForTest.Handle(context.Background(), &v1beta1.FlinkApplication{
-		Status: v1beta1.FlinkApplicationStatus{
-			Phase: v1beta1.FlinkApplicationRunning,
-		},
-	})
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
 	assert.Nil(t, err)
+	assert.Equal(t, []runtime.Object{jobManager, taskManager}, deleted)
+	assert.True(t, app.Status.FinishedJob.ClusterDeleted)
+	assert.Equal(t, "", app.Status.DeployHash)
 }
 
-func TestRunningToClusterStarting(t *testing.T) {
-	updateInvoked := false
+func TestRunningWithSessionJobs(t *testing.T) {
+	now := time.Now()
+	app := getSavepointScheduleTestApp(now)
+	app.Spec.SavepointSchedule = nil
+	app.Spec.Jobs = []v1beta1.SessionJob{
+		{Name: "enrichment", JarName: "enrichment.jar", Parallelism: 2, SavepointPath: "s3://savepoints/enrichment"},
+	}
+
 	stateMachineForTest := getTestStateMachine()
 	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
 	mockFlinkController.GetCurrentDeploymentsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) (*common.FlinkDeployment, error) {
-		return nil, nil
+		fd := testFlinkDeployment(application)
+		return &fd, nil
+	}
+	var clusterJobs []client.FlinkJob
+	mockFlinkController.GetJobsForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) ([]client.FlinkJob, error) {
+		assert.Equal(t, "hash", hash)
+		return clusterJobs, nil
+	}
+	var submittedFrom []string
+	mockFlinkController.StartFlinkJobFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string,
+		jarName string, parallelism int32, entryClass string, programArgs string,
+		allowNonRestoredState bool, savepointPath string) (string, error) {
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, "enrichment.jar", jarName)
+		submittedFrom = append(submittedFrom, savepointPath)
+		return []string{"e1", "e2"}[len(submittedFrom)-1], nil
+	}
+	mockFlinkController.SavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error) {
+		// only the job whose spec has changed is stopped
+		assert.Equal(t, "e1", jobID)
+		assert.True(t, isCancel)
+		return "t1", nil
+	}
+	mockFlinkController.GetSavepointStatusForTriggerFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error) {
+		assert.Equal(t, "t1", triggerID)
+		return getSuspendTestSavepointStatus(client.SavePointCompleted, testSavepointLocation), nil
 	}
 
-	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
-	mockK8Cluster.UpdateStatusFunc = func(ctx context.Context, object runtime.Object) error {
-		application := object.(*v1beta1.FlinkApplication)
-		assert.Equal(t, v1beta1.FlinkApplicationUpdating, application.Status.Phase)
-		updateInvoked = true
-		return nil
+	// the job is first submitted from its own savepoint
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"s3://savepoints/enrichment"}, submittedFrom)
+	assert.Equal(t, 1, len(app.Status.Jobs))
+	assert.Equal(t, "e1", app.Status.Jobs[0].JobStatus.JobID)
+	assert.Equal(t, flink.HashForSessionJob(&app.Spec.Jobs[0]), app.Status.Jobs[0].Hash)
+
+	clusterJobs = []client.FlinkJob{{JobID: "j1", Status: client.Running}, {JobID: "e1", Status: client.Running}}
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.Running, app.Status.Jobs[0].JobStatus.State)
+	assert.Equal(t, 1, len(submittedFrom))
+
+	// a change to the job stops it with a savepoint, and submits it again from there
+	app.Spec.Jobs[0].Parallelism = 4
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, "t1", app.Status.Jobs[0].SavepointTriggerID)
+
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, []string{"s3://savepoints/enrichment", testSavepointLocation}, submittedFrom)
+	assert.Equal(t, "e2", app.Status.Jobs[0].JobStatus.JobID)
+	assert.Equal(t, int32(4), app.Status.Jobs[0].JobStatus.Parallelism)
+	// the main job is not affected
+	assert.Equal(t, "j1", app.Status.JobStatus.JobID)
+
+	// a removed job is stopped with a savepoint before its status is dropped
+	clusterJobs = []client.FlinkJob{{JobID: "j1", Status: client.Running}, {JobID: "e2", Status: client.Running}}
+	app.Spec.Jobs = nil
+	mockFlinkController.SavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error) {
+		assert.Equal(t, "e2", jobID)
+		return "t1", nil
 	}
-	err := stateMachineForTest.Handle(context.Background(), &v1beta1.FlinkApplication{
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(app.Status.Jobs))
+
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Empty(t, app.Status.Jobs)
+	assert.Equal(t, 2, len(submittedFrom))
+}
+
+func TestSavepointingStopsSessionJobs(t *testing.T) {
+	app := v1beta1.FlinkApplication{
 		Status: v1beta1.FlinkApplicationStatus{
-			Phase: v1beta1.FlinkApplicationRunning,
+			Phase:      v1beta1.FlinkApplicationSavepointing,
+			DeployHash: "old-hash",
+			JobStatus:  v1beta1.FlinkJobStatus{JobID: "old-job"},
+			Jobs: []v1beta1.SessionJobStatus{
+				{Name: "enrichment", JobStatus: v1beta1.FlinkJobStatus{JobID: "e1"}},
+			},
 		},
-	})
-	assert.True(t, updateInvoked)
+	}
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	mockFlinkController.GetJobsForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) ([]client.FlinkJob, error) {
+		return []client.FlinkJob{{JobID: "old-job", Status: client.Running}, {JobID: "e1", Status: client.Running}}, nil
+	}
+	var savepointedJobs []string
+	mockFlinkController.SavepointFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, isCancel bool, jobID string) (string, error) {
+		assert.Equal(t, "old-hash", hash)
+		savepointedJobs = append(savepointedJobs, jobID)
+		return "t-" + jobID, nil
+	}
+	mockFlinkController.GetSavepointStatusForTriggerFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string, triggerID string) (*client.SavepointResponse, error) {
+		return getSuspendTestSavepointStatus(client.SavePointCompleted, testSavepointLocation), nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"e1"}, savepointedJobs)
+
+	// the main job is only savepointed once the other jobs have stopped
+	err = stateMachineForTest.Handle(context.Background(), &app)
 	assert.Nil(t, err)
+	assert.Equal(t, []string{"e1", "old-job"}, savepointedJobs)
+	assert.Equal(t, "", app.Status.Jobs[0].JobStatus.JobID)
+	assert.Equal(t, testSavepointLocation, app.Status.Jobs[0].SavepointPath)
+	assert.Equal(t, "t-old-job", app.Status.SavepointTriggerID)
 }
 
 func TestRollingBack(t *testing.T) {
@@ -1043,6 +2742,12 @@ func TestDeleteModeNone(t *testing.T) {
 		return nil
 	}
 
+	haConfigMapsDeleted := false
+	mockFlinkController.DeleteHAConfigMapsForAppFunc = func(ctx context.Context, application *v1beta1.FlinkApplication) error {
+		haConfigMapsDeleted = true
+		return nil
+	}
+
 	mockK8Cluster := stateMachineForTest.k8Cluster.(*k8mock.K8Cluster)
 	updateCount := 1
 	mockK8Cluster.UpdateK8ObjectFunc = func(ctx context.Context, object runtime.Object) error {
@@ -1061,6 +2766,7 @@ func TestDeleteModeNone(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 2, updateCount)
 	assert.False(t, cancelled)
+	assert.True(t, haConfigMapsDeleted)
 }
 
 func TestHandleInvalidPhase(t *testing.T) {
@@ -1778,6 +3484,176 @@ func TestDualRunningToRunning(t *testing.T) {
 	assert.Equal(t, v1beta1.BlueFlinkApplication, app.Status.DeployVersion)
 }
 
+func getPromotionTestApp() v1beta1.FlinkApplication {
+	return v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "flink",
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			JarName:        "job.jar",
+			Parallelism:    5,
+			DeploymentMode: v1beta1.DeploymentModeBlueGreen,
+			BlueGreen: &v1beta1.BlueGreenConfig{
+				PromotionPolicy: &v1beta1.PromotionPolicy{
+					SoakDuration: &metav1.Duration{Duration: 30 * time.Minute},
+				},
+			},
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:           v1beta1.FlinkApplicationDualRunning,
+			DeploymentMode:  v1beta1.DeploymentModeBlueGreen,
+			DeployHash:      "deployHash",
+			UpdatingHash:    "updatingHash",
+			DeployVersion:   v1beta1.GreenFlinkApplication,
+			UpdatingVersion: v1beta1.BlueFlinkApplication,
+			VersionStatuses: []v1beta1.FlinkApplicationVersionStatus{
+				{
+					JobStatus: v1beta1.FlinkJobStatus{
+						JobID: "jobId",
+						State: v1beta1.Running,
+					},
+					VersionHash: "deployHash",
+					Version:     v1beta1.GreenFlinkApplication,
+				},
+				{
+					JobStatus: v1beta1.FlinkJobStatus{
+						JobID:           "jobId2",
+						State:           v1beta1.Running,
+						Health:          v1beta1.Green,
+						JobRestartCount: 1,
+					},
+					VersionHash: "updatingHash",
+					Version:     v1beta1.BlueFlinkApplication,
+				},
+			},
+		},
+	}
+}
+
+func TestDualRunningWithPromotionPolicy(t *testing.T) {
+	now := time.Now()
+	app := getPromotionTestApp()
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	var deletedHash string
+	mockFlinkController.DeleteResourcesForAppWithHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) error {
+		deletedHash = hash
+		return nil
+	}
+	mockFlinkController.GetVersionAndJobIDForHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (string, string, error) {
+		assert.Equal(t, "deployHash", hash)
+		return string(v1beta1.GreenFlinkApplication), "jobId", nil
+	}
+
+	// the soak window starts
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationDualRunning, app.Status.Phase)
+	assert.Equal(t, "updatingHash", app.Status.Promotion.Hash)
+	assert.Equal(t, v1beta1.PromotionPending, app.Status.Promotion.Decision)
+	assert.Equal(t, int32(1), app.Status.Promotion.InitialJobRestartCount)
+
+	// the new job is checkpointing, but the soak window hasn't passed yet
+	app.Status.VersionStatuses[1].JobStatus.CompletedCheckpointCount = 5
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now.Add(10 * time.Minute))
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationDualRunning, app.Status.Phase)
+	assert.Equal(t, "", deletedHash)
+
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now.Add(30 * time.Minute))
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, "deployHash", deletedHash)
+	assert.Equal(t, "updatingHash", app.Status.DeployHash)
+	assert.Equal(t, v1beta1.PromotionPromoted, app.Status.Promotion.Decision)
+	assert.NotNil(t, app.Status.Promotion.DecisionTime)
+}
+
+func TestDualRunningWithFailedPromotion(t *testing.T) {
+	now := time.Now()
+	app := getPromotionTestApp()
+	app.Status.Promotion = &v1beta1.PromotionStatus{
+		Hash:                   "updatingHash",
+		SoakStartTime:          metav1.NewTime(now.Add(-5 * time.Minute)),
+		InitialJobRestartCount: 1,
+		Decision:               v1beta1.PromotionPending,
+	}
+	// the new job has restarted since the soak window started
+	app.Status.VersionStatuses[1].JobStatus.JobRestartCount = 2
+
+	stateMachineForTest := getTestStateMachine()
+	stateMachineForTest.clock.(*clock.FakeClock).SetTime(now)
+
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	var deletedHash string
+	mockFlinkController.DeleteResourcesForAppWithHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) error {
+		deletedHash = hash
+		return nil
+	}
+	mockFlinkController.GetVersionAndJobIDForHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (string, string, error) {
+		return string(v1beta1.BlueFlinkApplication), "jobId2", nil
+	}
+	mockFlinkController.ForceCancelFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string, jobID string) error {
+		assert.Equal(t, "updatingHash", hash)
+		assert.Equal(t, "jobId2", jobID)
+		return nil
+	}
+
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, "updatingHash", deletedHash)
+	assert.Equal(t, v1beta1.PromotionRolledBack, app.Status.Promotion.Decision)
+	assert.Equal(t, "the job restarted 1 times during the soak window, more than the allowed 0",
+		app.Status.Promotion.Reason)
+}
+
+func TestDualRunningWithFinishedBoundedJob(t *testing.T) {
+	app := getPromotionTestApp()
+	app.Spec.BoundedJob = &v1beta1.BoundedJobConfig{}
+
+	stateMachineForTest := getTestStateMachine()
+	mockFlinkController := stateMachineForTest.flinkController.(*mock.FlinkController)
+	jobState := client.Finished
+	mockFlinkController.GetJobForApplicationFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (*client.FlinkJobOverview, error) {
+		assert.Equal(t, "updatingHash", hash)
+		return &client.FlinkJobOverview{JobID: "jobId2", State: jobState}, nil
+	}
+	var deletedHash string
+	mockFlinkController.DeleteResourcesForAppWithHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) error {
+		deletedHash = hash
+		return nil
+	}
+	mockFlinkController.GetVersionAndJobIDForHashFunc = func(ctx context.Context, application *v1beta1.FlinkApplication, hash string) (string, string, error) {
+		if hash == "deployHash" {
+			return string(v1beta1.GreenFlinkApplication), "jobId", nil
+		}
+		return string(v1beta1.BlueFlinkApplication), "jobId2", nil
+	}
+
+	// the finished job of the new version replaces the previous version, and is then recorded as finished in Running
+	err := stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, "deployHash", deletedHash)
+	assert.Equal(t, "updatingHash", app.Status.DeployHash)
+	assert.Nil(t, app.Status.Promotion)
+
+	// a failed job of the new version is torn down instead
+	app = getPromotionTestApp()
+	app.Spec.BoundedJob = &v1beta1.BoundedJobConfig{}
+	jobState = client.Failed
+	err = stateMachineForTest.Handle(context.Background(), &app)
+	assert.Nil(t, err)
+	assert.Equal(t, v1beta1.FlinkApplicationRunning, app.Status.Phase)
+	assert.Equal(t, "updatingHash", deletedHash)
+}
+
 func TestBlueGreenUpdateWithError(t *testing.T) {
 	deployHash := "deployHash"
 	updatingHash := "updateHash"
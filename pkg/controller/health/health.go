@@ -0,0 +1,176 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lyft/flytestdlib/logger"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sCache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type LeaderChecker interface {
+	IsLeader() bool
+}
+
+// Status tracks the health of the operator process: whether its caches have synced, whether it is the leader, and
+// whether its reconcile loop is making progress. It backs the /healthz and /readyz endpoints.
+type Status struct {
+	leader       LeaderChecker
+	stuckTimeout time.Duration
+	now          func() time.Time
+
+	mu            sync.Mutex
+	cacheSynced   bool
+	inFlight      int
+	busySince     time.Time
+	lastSucceeded time.Time
+}
+
+func NewStatus(leader LeaderChecker, stuckTimeout time.Duration) *Status {
+	return &Status{
+		leader:       leader,
+		stuckTimeout: stuckTimeout,
+		now:          time.Now,
+	}
+}
+
+// Blocks until the informers of the given objects have synced, and marks the caches as synced
+func (s *Status) WaitForCacheSync(ctx context.Context, c cache.Cache, stop <-chan struct{}, objs ...runtime.Object) {
+	var synced []k8sCache.InformerSynced
+	for _, obj := range objs {
+		informer, err := c.GetInformer(obj)
+		if err != nil {
+			logger.Errorf(ctx, "Failed to get informer for %T: %v", obj, err)
+			return
+		}
+		synced = append(synced, informer.HasSynced)
+	}
+
+	if !k8sCache.WaitForCacheSync(stop, synced...) {
+		return
+	}
+	logger.Infof(ctx, "Caches synced")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cacheSynced = true
+}
+
+// Wraps a reconciler to record the progress of the reconcile loop
+func (s *Status) TrackReconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return &trackingReconciler{status: s, reconciler: r}
+}
+
+type trackingReconciler struct {
+	status     *Status
+	reconciler reconcile.Reconciler
+}
+
+func (t *trackingReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	t.status.reconcileStarted()
+	result, err := t.reconciler.Reconcile(request)
+	t.status.reconcileFinished(err)
+	return result, err
+}
+
+func (s *Status) reconcileStarted() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inFlight == 0 {
+		s.busySince = s.now()
+	}
+	s.inFlight++
+}
+
+func (s *Status) reconcileFinished(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+	// any reconcile that returns counts as progress for the ones still running
+	s.busySince = s.now()
+	if err == nil {
+		s.lastSucceeded = s.now()
+	}
+}
+
+type check struct {
+	name    string
+	ok      bool
+	message string
+}
+
+// The reconcile loop is stuck if reconciles are running and none of them has returned for longer than stuckTimeout
+func (s *Status) reconcileCheck() check {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	message := "no successful reconcile yet"
+	if !s.lastSucceeded.IsZero() {
+		message = fmt.Sprintf("last successful reconcile at %s", s.lastSucceeded.UTC().Format(time.RFC3339))
+	}
+	if s.inFlight > 0 && s.stuckTimeout > 0 {
+		if busy := s.now().Sub(s.busySince); busy > s.stuckTimeout {
+			return check{"reconcile", false, fmt.Sprintf("%d reconciles made no progress for %v, %s",
+				s.inFlight, busy.Round(time.Second), message)}
+		}
+	}
+	return check{"reconcile", true, message}
+}
+
+func (s *Status) cacheCheck() check {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.cacheSynced {
+		return check{"cache", false, "caches not synced"}
+	}
+	return check{"cache", true, "caches synced"}
+}
+
+// Leadership is reported but never fails a check, as standby replicas are healthy and ready to take over
+func (s *Status) leaderCheck() check {
+	if s.leader != nil && s.leader.IsLeader() {
+		return check{"leader", true, "leader"}
+	}
+	return check{"leader", true, "standby"}
+}
+
+func serveChecks(w http.ResponseWriter, checks ...check) {
+	var body strings.Builder
+	healthy := true
+	for _, c := range checks {
+		sign := "+"
+		if !c.ok {
+			sign = "-"
+			healthy = false
+		}
+		body.WriteString(fmt.Sprintf("[%s]%s: %s\n", sign, c.name, c.message))
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if healthy {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = w.Write([]byte(body.String()))
+}
+
+// Liveness fails only when the reconcile loop is stuck, so that the process is restarted
+func (s *Status) Liveness() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serveChecks(w, s.leaderCheck(), s.reconcileCheck())
+	})
+}
+
+// Readiness additionally requires the caches to have synced
+func (s *Status) Readiness() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serveChecks(w, s.leaderCheck(), s.cacheCheck(), s.reconcileCheck())
+	})
+}
@@ -0,0 +1,106 @@
+package health
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type testLeader bool
+
+func (l testLeader) IsLeader() bool {
+	return bool(l)
+}
+
+type testReconciler struct {
+	reconcile func() error
+}
+
+func (r *testReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	return reconcile.Result{}, r.reconcile()
+}
+
+func getTestStatus(leader bool) (*Status, *time.Time) {
+	now := time.Date(2019, 11, 1, 10, 0, 0, 0, time.UTC)
+	status := NewStatus(testLeader(leader), 10*time.Minute)
+	status.now = func() time.Time {
+		return now
+	}
+	return status, &now
+}
+
+func inFlight(status *Status) int {
+	status.mu.Lock()
+	defer status.mu.Unlock()
+	return status.inFlight
+}
+
+func serve(handler http.Handler) (int, string) {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	return recorder.Code, recorder.Body.String()
+}
+
+func TestReadiness(t *testing.T) {
+	status, _ := getTestStatus(false)
+
+	code, body := serve(status.Readiness())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "[+]leader: standby\n[-]cache: caches not synced\n[+]reconcile: no successful reconcile yet\n", body)
+
+	status.cacheSynced = true
+	code, body = serve(status.Readiness())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "[+]leader: standby\n[+]cache: caches synced\n[+]reconcile: no successful reconcile yet\n", body)
+}
+
+func TestLivenessStuckReconcile(t *testing.T) {
+	status, now := getTestStatus(true)
+
+	var reconcileErr error
+	release := make(chan struct{})
+	reconciler := status.TrackReconciler(&testReconciler{reconcile: func() error {
+		<-release
+		return reconcileErr
+	}})
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = reconciler.Reconcile(reconcile.Request{})
+		close(done)
+	}()
+	for i := 0; i < 1000 && inFlight(status) == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+
+	*now = now.Add(5 * time.Minute)
+	code, _ := serve(status.Liveness())
+	assert.Equal(t, http.StatusOK, code)
+
+	*now = now.Add(10 * time.Minute)
+	code, body := serve(status.Liveness())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "[+]leader: leader\n[-]reconcile: 1 reconciles made no progress for 15m0s, no successful reconcile yet\n", body)
+
+	close(release)
+	<-done
+	code, body = serve(status.Liveness())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "[+]leader: leader\n[+]reconcile: last successful reconcile at 2019-11-01T10:15:00Z\n", body)
+
+	// failed reconciles still count as progress, but do not update the last successful reconcile
+	reconcileErr = errors.New("failed")
+	release = make(chan struct{})
+	close(release)
+	*now = now.Add(time.Hour)
+	_, err := reconciler.Reconcile(reconcile.Request{})
+	assert.NotNil(t, err)
+	code, body = serve(status.Liveness())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "[+]leader: leader\n[+]reconcile: last successful reconcile at 2019-11-01T10:15:00Z\n", body)
+}
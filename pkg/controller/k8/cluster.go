@@ -11,6 +11,7 @@ import (
 	"github.com/lyft/flytestdlib/promutils/labeled"
 	v1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
+	policyV1beta1 "k8s.io/api/policy/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -20,21 +21,39 @@ import (
 )
 
 const (
-	Deployment = "Deployment"
-	Pod        = "Pod"
-	Service    = "Service"
-	Endpoints  = "Endpoints"
-	Ingress    = "Ingress"
+	Deployment            = "Deployment"
+	StatefulSet           = "StatefulSet"
+	Pod                   = "Pod"
+	Service               = "Service"
+	Endpoints             = "Endpoints"
+	Ingress               = "Ingress"
+	ConfigMap             = "ConfigMap"
+	PersistentVolumeClaim = "PersistentVolumeClaim"
+	PodDisruptionBudget   = "PodDisruptionBudget"
 )
 
 type ClusterInterface interface {
 	// Tries to fetch the value from the controller runtime manager cache, if it does not exist, call API server
 	GetDeploymentsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error)
 
+	// Tries to fetch the value from the controller runtime manager cache, if it does not exist, call API server
+	GetStatefulSetsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*v1.StatefulSetList, error)
+
 	// Tries to fetch the value from the controller runtime manager cache, if it does not exist, call API server
 	GetService(ctx context.Context, namespace string, name string, version string) (*coreV1.Service, error)
 	GetServicesWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*coreV1.ServiceList, error)
 
+	// Tries to fetch the value from the controller runtime manager cache, if it does not exist, call API server
+	GetConfigMapsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*coreV1.ConfigMapList, error)
+
+	// Tries to fetch the value from the controller runtime manager cache, if it does not exist, call API server
+	GetPersistentVolumeClaimsWithLabel(ctx context.Context, namespace string,
+		labelMap map[string]string) (*coreV1.PersistentVolumeClaimList, error)
+
+	// Tries to fetch the value from the controller runtime manager cache, if it does not exist, call API server
+	GetPodDisruptionBudgetsWithLabel(ctx context.Context, namespace string,
+		labelMap map[string]string) (*policyV1beta1.PodDisruptionBudgetList, error)
+
 	CreateK8Object(ctx context.Context, object runtime.Object) error
 	UpdateK8Object(ctx context.Context, object runtime.Object) error
 	DeleteK8Object(ctx context.Context, object runtime.Object) error
@@ -174,6 +193,106 @@ func (k *Cluster) GetServicesWithLabel(ctx context.Context, namespace string, la
 	return serviceList, nil
 }
 
+func (k *Cluster) GetConfigMapsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*coreV1.ConfigMapList, error) {
+	configMapList := &coreV1.ConfigMapList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: coreV1.SchemeGroupVersion.String(),
+			Kind:       ConfigMap,
+		},
+	}
+	namespaceOpt := client.InNamespace(namespace)
+	matchLabel := client.MatchingLabels(labelMap)
+
+	// Config maps are only listed when clusters are torn down, so they are read directly rather than through the
+	// cache, which would start an informer watching all config maps in the cluster
+	err := k.client.List(ctx, configMapList, namespaceOpt, matchLabel)
+	if err != nil {
+		logger.Warnf(ctx, "Failed to list config maps %v", err)
+		return nil, err
+	}
+	return configMapList, nil
+}
+
+func (k *Cluster) GetStatefulSetsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*v1.StatefulSetList, error) {
+	statefulSetList := &v1.StatefulSetList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       StatefulSet,
+		},
+	}
+	namespaceOpt := client.InNamespace(namespace)
+	matchLabel := client.MatchingLabels(labelMap)
+
+	err := k.cache.List(ctx, statefulSetList, namespaceOpt, matchLabel)
+	if err != nil {
+		if IsK8sObjectDoesNotExist(err) {
+			err := k.client.List(ctx, statefulSetList, namespaceOpt, matchLabel)
+			if err != nil {
+				logger.Warnf(ctx, "Failed to list stateful sets %v", err)
+				return nil, err
+			}
+			return statefulSetList, nil
+		}
+		logger.Warnf(ctx, "Failed to list stateful sets from cache %v", err)
+		return nil, err
+	}
+	return statefulSetList, nil
+}
+
+func (k *Cluster) GetPersistentVolumeClaimsWithLabel(ctx context.Context, namespace string,
+	labelMap map[string]string) (*coreV1.PersistentVolumeClaimList, error) {
+	claimList := &coreV1.PersistentVolumeClaimList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: coreV1.SchemeGroupVersion.String(),
+			Kind:       PersistentVolumeClaim,
+		},
+	}
+	namespaceOpt := client.InNamespace(namespace)
+	matchLabel := client.MatchingLabels(labelMap)
+
+	err := k.cache.List(ctx, claimList, namespaceOpt, matchLabel)
+	if err != nil {
+		if IsK8sObjectDoesNotExist(err) {
+			err := k.client.List(ctx, claimList, namespaceOpt, matchLabel)
+			if err != nil {
+				logger.Warnf(ctx, "Failed to list persistent volume claims %v", err)
+				return nil, err
+			}
+			return claimList, nil
+		}
+		logger.Warnf(ctx, "Failed to list persistent volume claims from cache %v", err)
+		return nil, err
+	}
+	return claimList, nil
+}
+
+func (k *Cluster) GetPodDisruptionBudgetsWithLabel(ctx context.Context, namespace string,
+	labelMap map[string]string) (*policyV1beta1.PodDisruptionBudgetList, error) {
+	budgetList := &policyV1beta1.PodDisruptionBudgetList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: policyV1beta1.SchemeGroupVersion.String(),
+			Kind:       PodDisruptionBudget,
+		},
+	}
+	namespaceOpt := client.InNamespace(namespace)
+	matchLabel := client.MatchingLabels(labelMap)
+
+	err := k.cache.List(ctx, budgetList, namespaceOpt, matchLabel)
+	if err != nil {
+		if IsK8sObjectDoesNotExist(err) {
+			err := k.client.List(ctx, budgetList, namespaceOpt, matchLabel)
+			if err != nil {
+				logger.Warnf(ctx, "Failed to list pod disruption budgets %v", err)
+				return nil, err
+			}
+			return budgetList, nil
+		}
+		logger.Warnf(ctx, "Failed to list pod disruption budgets from cache %v", err)
+		return nil, err
+	}
+	return budgetList, nil
+}
+
 func (k *Cluster) CreateK8Object(ctx context.Context, object runtime.Object) error {
 	objCreate := object.DeepCopyObject()
 	err := k.client.Create(ctx, objCreate)
@@ -5,25 +5,34 @@ import (
 
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type GetDeploymentsWithLabelFunc func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error)
+type GetStatefulSetsWithLabelFunc func(ctx context.Context, namespace string, labelMap map[string]string) (*v1.StatefulSetList, error)
+type GetPersistentVolumeClaimsWithLabelFunc func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.PersistentVolumeClaimList, error)
+type GetPodDisruptionBudgetsWithLabelFunc func(ctx context.Context, namespace string, labelMap map[string]string) (*policyv1beta1.PodDisruptionBudgetList, error)
 type CreateK8ObjectFunc func(ctx context.Context, object runtime.Object) error
 type GetServiceFunc func(ctx context.Context, namespace string, name string, version string) (*corev1.Service, error)
 type GetServiceWithLabelFunc func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error)
+type GetConfigMapsWithLabelFunc func(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ConfigMapList, error)
 type UpdateK8ObjectFunc func(ctx context.Context, object runtime.Object) error
 type UpdateStatusFunc func(ctx context.Context, object runtime.Object) error
 type DeleteK8ObjectFunc func(ctx context.Context, object runtime.Object) error
 
 type K8Cluster struct {
-	GetDeploymentsWithLabelFunc GetDeploymentsWithLabelFunc
-	GetServiceFunc              GetServiceFunc
-	GetServicesWithLabelFunc    GetServiceWithLabelFunc
-	CreateK8ObjectFunc          CreateK8ObjectFunc
-	UpdateK8ObjectFunc          UpdateK8ObjectFunc
-	UpdateStatusFunc            UpdateStatusFunc
-	DeleteK8ObjectFunc          DeleteK8ObjectFunc
+	GetDeploymentsWithLabelFunc            GetDeploymentsWithLabelFunc
+	GetStatefulSetsWithLabelFunc           GetStatefulSetsWithLabelFunc
+	GetServiceFunc                         GetServiceFunc
+	GetServicesWithLabelFunc               GetServiceWithLabelFunc
+	GetConfigMapsWithLabelFunc             GetConfigMapsWithLabelFunc
+	GetPersistentVolumeClaimsWithLabelFunc GetPersistentVolumeClaimsWithLabelFunc
+	GetPodDisruptionBudgetsWithLabelFunc   GetPodDisruptionBudgetsWithLabelFunc
+	CreateK8ObjectFunc                     CreateK8ObjectFunc
+	UpdateK8ObjectFunc                     UpdateK8ObjectFunc
+	UpdateStatusFunc                       UpdateStatusFunc
+	DeleteK8ObjectFunc                     DeleteK8ObjectFunc
 }
 
 func (m *K8Cluster) GetDeploymentsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*v1.DeploymentList, error) {
@@ -33,6 +42,14 @@ func (m *K8Cluster) GetDeploymentsWithLabel(ctx context.Context, namespace strin
 	return nil, nil
 }
 
+// Returns an empty list if unset, as most tests only set up deployments
+func (m *K8Cluster) GetStatefulSetsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*v1.StatefulSetList, error) {
+	if m.GetStatefulSetsWithLabelFunc != nil {
+		return m.GetStatefulSetsWithLabelFunc(ctx, namespace, labelMap)
+	}
+	return &v1.StatefulSetList{}, nil
+}
+
 func (m *K8Cluster) GetServicesWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ServiceList, error) {
 	if m.GetDeploymentsWithLabelFunc != nil {
 		return m.GetServicesWithLabelFunc(ctx, namespace, labelMap)
@@ -40,6 +57,27 @@ func (m *K8Cluster) GetServicesWithLabel(ctx context.Context, namespace string,
 	return nil, nil
 }
 
+func (m *K8Cluster) GetConfigMapsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.ConfigMapList, error) {
+	if m.GetConfigMapsWithLabelFunc != nil {
+		return m.GetConfigMapsWithLabelFunc(ctx, namespace, labelMap)
+	}
+	return &corev1.ConfigMapList{}, nil
+}
+
+func (m *K8Cluster) GetPersistentVolumeClaimsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*corev1.PersistentVolumeClaimList, error) {
+	if m.GetPersistentVolumeClaimsWithLabelFunc != nil {
+		return m.GetPersistentVolumeClaimsWithLabelFunc(ctx, namespace, labelMap)
+	}
+	return &corev1.PersistentVolumeClaimList{}, nil
+}
+
+func (m *K8Cluster) GetPodDisruptionBudgetsWithLabel(ctx context.Context, namespace string, labelMap map[string]string) (*policyv1beta1.PodDisruptionBudgetList, error) {
+	if m.GetPodDisruptionBudgetsWithLabelFunc != nil {
+		return m.GetPodDisruptionBudgetsWithLabelFunc(ctx, namespace, labelMap)
+	}
+	return &policyv1beta1.PodDisruptionBudgetList{}, nil
+}
+
 func (m *K8Cluster) GetService(ctx context.Context, namespace string, name string, version string) (*corev1.Service, error) {
 	if m.GetServiceFunc != nil {
 		return m.GetServiceFunc(ctx, namespace, name, version)
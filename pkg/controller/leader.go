@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/lyft/flytestdlib/logger"
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// LeaderStatus tracks whether this replica of the operator is the one reconciling applications. It is added to the
+// manager as a runnable, which the manager only starts once this replica has been elected leader (or right away if
+// leader election is disabled).
+type LeaderStatus struct {
+	isLeader int32
+	gauge    prometheus.Gauge
+}
+
+func NewLeaderStatus(scope promutils.Scope) *LeaderStatus {
+	return &LeaderStatus{
+		gauge: scope.MustNewGauge("is_leader", "1 if this replica of the operator is the leader, 0 otherwise"),
+	}
+}
+
+func (l *LeaderStatus) Start(stop <-chan struct{}) error {
+	logger.Infof(context.Background(), "Elected leader, reconciling applications")
+	atomic.StoreInt32(&l.isLeader, 1)
+	l.gauge.Set(1)
+
+	<-stop
+	atomic.StoreInt32(&l.isLeader, 0)
+	l.gauge.Set(0)
+	return nil
+}
+
+func (l *LeaderStatus) IsLeader() bool {
+	return atomic.LoadInt32(&l.isLeader) == 1
+}
+
+// Serves 200 if this replica is the leader, and 503 if it is on standby
+func (l *LeaderStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if l.IsLeader() {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("leader"))
+		return
+	}
+	w.WriteHeader(http.StatusServiceUnavailable)
+	_, _ = w.Write([]byte("standby"))
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lyft/flytestdlib/promutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderStatus(t *testing.T) {
+	status := NewLeaderStatus(promutils.NewTestScope())
+	assert.False(t, status.IsLeader())
+
+	recorder := httptest.NewRecorder()
+	status.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/leader", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "standby", recorder.Body.String())
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		assert.Nil(t, status.Start(stop))
+		close(done)
+	}()
+	for i := 0; i < 1000 && !status.IsLeader(); i++ {
+		time.Sleep(time.Millisecond)
+	}
+	assert.True(t, status.IsLeader())
+
+	recorder = httptest.NewRecorder()
+	status.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/leader", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	close(stop)
+	<-done
+	assert.False(t, status.IsLeader())
+}
@@ -0,0 +1,68 @@
+package savepoint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+)
+
+// The number of failed scheduled savepoints that are kept in the status
+const maxFailedSavepoints = 5
+
+// NextTime returns the time at which the next scheduled savepoint is due, one interval after the time the last one was
+// triggered or, before that, after the time the job was started
+func NextTime(schedule *v1beta1.SavepointSchedule, status *v1beta1.SavepointScheduleStatus, jobStartTime time.Time) (time.Time, error) {
+	if schedule.Interval == nil || schedule.Interval.Duration <= 0 {
+		return time.Time{}, fmt.Errorf("savepoint interval must be positive")
+	}
+
+	since := jobStartTime
+	if status != nil && status.LastTriggerTime != nil && status.LastTriggerTime.After(since) {
+		since = status.LastTriggerTime.Time
+	}
+	return since.Add(schedule.Interval.Duration), nil
+}
+
+func getRetainCount(schedule *v1beta1.SavepointSchedule) int {
+	if schedule.RetainCount == nil {
+		return v1beta1.DefaultSavepointRetainCount
+	}
+	return int(*schedule.RetainCount)
+}
+
+// ApplyRetention splits the scheduled savepoints into those that are kept in the status and the successful savepoints
+// that have expired, either because more recent ones exceed the retain count, or because they are older than the
+// retention period. Savepoints in progress are always kept, and only the most recent failures. Expired savepoints that
+// could not be deleted count as failures, and are not returned as expired again.
+func ApplyRetention(schedule *v1beta1.SavepointSchedule, savepoints []v1beta1.ScheduledSavepoint, now time.Time) (
+	retained []v1beta1.ScheduledSavepoint, expired []v1beta1.ScheduledSavepoint) {
+	succeeded := 0
+	failed := 0
+	// savepoints are ordered oldest first, so walk backwards to count the most recent ones
+	keep := make([]bool, len(savepoints))
+	for i := len(savepoints) - 1; i >= 0; i-- {
+		savepoint := savepoints[i]
+		switch {
+		case savepoint.State == v1beta1.SavepointSucceeded && savepoint.DeletionFailure == "":
+			succeeded++
+			keep[i] = succeeded <= getRetainCount(schedule) &&
+				(schedule.RetainFor == nil || now.Sub(savepoint.TriggerTime.Time) <= schedule.RetainFor.Duration)
+			if !keep[i] {
+				expired = append([]v1beta1.ScheduledSavepoint{savepoint}, expired...)
+			}
+		case savepoint.State == v1beta1.SavepointFailed || savepoint.DeletionFailure != "":
+			failed++
+			keep[i] = failed <= maxFailedSavepoints
+		default:
+			keep[i] = true
+		}
+	}
+
+	for i, savepoint := range savepoints {
+		if keep[i] {
+			retained = append(retained, savepoint)
+		}
+	}
+	return retained, expired
+}
@@ -0,0 +1,119 @@
+package savepoint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func scheduledSavepoint(id string, state v1beta1.SavepointState, triggerTime time.Time) v1beta1.ScheduledSavepoint {
+	return v1beta1.ScheduledSavepoint{
+		TriggerID:   id,
+		TriggerTime: metav1.NewTime(triggerTime),
+		State:       state,
+		Location:    "file:///savepoints/" + id,
+	}
+}
+
+func triggerIDs(savepoints []v1beta1.ScheduledSavepoint) []string {
+	ids := []string{}
+	for _, savepoint := range savepoints {
+		ids = append(ids, savepoint.TriggerID)
+	}
+	return ids
+}
+
+func parseTime(t *testing.T, value string) time.Time {
+	result, err := time.Parse(time.RFC3339, value)
+	assert.Nil(t, err)
+	return result
+}
+
+func TestNextTime(t *testing.T) {
+	start := parseTime(t, "2019-09-01T10:17:30Z")
+	schedule := &v1beta1.SavepointSchedule{Interval: &metav1.Duration{Duration: time.Hour}}
+
+	next, err := NextTime(schedule, nil, start)
+	assert.Nil(t, err)
+	assert.Equal(t, start.Add(time.Hour), next)
+
+	lastTrigger := metav1.NewTime(parseTime(t, "2019-09-01T12:00:00Z"))
+	status := &v1beta1.SavepointScheduleStatus{LastTriggerTime: &lastTrigger}
+	next, err = NextTime(schedule, status, start)
+	assert.Nil(t, err)
+	assert.Equal(t, parseTime(t, "2019-09-01T13:00:00Z"), next)
+
+	// a job that was restarted since is only savepointed after the next interval
+	next, err = NextTime(schedule, status, parseTime(t, "2019-09-01T12:30:00Z"))
+	assert.Nil(t, err)
+	assert.Equal(t, parseTime(t, "2019-09-01T13:30:00Z"), next)
+
+	_, err = NextTime(&v1beta1.SavepointSchedule{}, status, start)
+	assert.NotNil(t, err)
+}
+
+func TestApplyRetentionCount(t *testing.T) {
+	now := parseTime(t, "2019-09-01T10:00:00Z")
+	count := int32(2)
+	schedule := &v1beta1.SavepointSchedule{RetainCount: &count}
+
+	savepoints := []v1beta1.ScheduledSavepoint{
+		scheduledSavepoint("1", v1beta1.SavepointSucceeded, now.Add(-5*time.Hour)),
+		scheduledSavepoint("2", v1beta1.SavepointSucceeded, now.Add(-4*time.Hour)),
+		scheduledSavepoint("3", v1beta1.SavepointFailed, now.Add(-3*time.Hour)),
+		scheduledSavepoint("4", v1beta1.SavepointSucceeded, now.Add(-2*time.Hour)),
+		scheduledSavepoint("5", v1beta1.SavepointSucceeded, now.Add(-1*time.Hour)),
+		scheduledSavepoint("6", v1beta1.SavepointInProgress, now),
+	}
+	retained, expired := ApplyRetention(schedule, savepoints, now)
+	assert.Equal(t, []string{"3", "4", "5", "6"}, triggerIDs(retained))
+	assert.Equal(t, []string{"1", "2"}, triggerIDs(expired))
+}
+
+func TestApplyRetentionAge(t *testing.T) {
+	now := parseTime(t, "2019-09-01T10:00:00Z")
+	schedule := &v1beta1.SavepointSchedule{RetainFor: &metav1.Duration{Duration: 3 * time.Hour}}
+
+	savepoints := []v1beta1.ScheduledSavepoint{
+		scheduledSavepoint("1", v1beta1.SavepointSucceeded, now.Add(-5*time.Hour)),
+		scheduledSavepoint("2", v1beta1.SavepointSucceeded, now.Add(-3*time.Hour)),
+		scheduledSavepoint("3", v1beta1.SavepointSucceeded, now.Add(-1*time.Hour)),
+	}
+	retained, expired := ApplyRetention(schedule, savepoints, now)
+	assert.Equal(t, []string{"2", "3"}, triggerIDs(retained))
+	assert.Equal(t, []string{"1"}, triggerIDs(expired))
+}
+
+func TestApplyRetentionFailures(t *testing.T) {
+	now := parseTime(t, "2019-09-01T10:00:00Z")
+	var savepoints []v1beta1.ScheduledSavepoint
+	for i := 0; i < 8; i++ {
+		savepoints = append(savepoints, scheduledSavepoint(string(rune('a'+i)), v1beta1.SavepointFailed, now))
+	}
+
+	// failures are dropped from the status, but there is nothing to delete
+	retained, expired := ApplyRetention(&v1beta1.SavepointSchedule{}, savepoints, now)
+	assert.Equal(t, []string{"d", "e", "f", "g", "h"}, triggerIDs(retained))
+	assert.Empty(t, expired)
+}
+
+func TestApplyRetentionDeletionFailures(t *testing.T) {
+	now := parseTime(t, "2019-09-01T10:00:00Z")
+	count := int32(1)
+	schedule := &v1beta1.SavepointSchedule{RetainCount: &count}
+
+	savepoints := []v1beta1.ScheduledSavepoint{
+		scheduledSavepoint("1", v1beta1.SavepointSucceeded, now.Add(-3*time.Hour)),
+		scheduledSavepoint("2", v1beta1.SavepointSucceeded, now.Add(-2*time.Hour)),
+		scheduledSavepoint("3", v1beta1.SavepointSucceeded, now.Add(-1*time.Hour)),
+	}
+	savepoints[0].DeletionFailure = "permission denied"
+
+	// a savepoint that could not be deleted is kept like a failure, and not expired again
+	retained, expired := ApplyRetention(schedule, savepoints, now)
+	assert.Equal(t, []string{"1", "3"}, triggerIDs(retained))
+	assert.Equal(t, []string{"2"}, triggerIDs(expired))
+}
@@ -0,0 +1,66 @@
+package savepoint
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// Storage removes savepoints that are no longer retained from the file system they were written to. Implementations
+// for other file systems (e.g. S3) can be added to the Storages returned by NewStorage.
+type Storage interface {
+	// Deletes the savepoint directory at the given location. Deleting a savepoint that does not exist is not an error.
+	Delete(ctx context.Context, location string) error
+}
+
+// Storages implements Storage by delegating to the Storage registered for the scheme of the savepoint location
+type Storages map[string]Storage
+
+func (s Storages) Delete(ctx context.Context, location string) error {
+	u, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("invalid savepoint location %s: %v", location, err)
+	}
+	storage, ok := s[u.Scheme]
+	if !ok {
+		return fmt.Errorf("deleting savepoints with scheme '%s' is not supported", u.Scheme)
+	}
+	return storage.Delete(ctx, location)
+}
+
+// Supports returns whether a Storage is registered for the scheme of the savepoint location
+func (s Storages) Supports(location string) bool {
+	u, err := url.Parse(location)
+	if err != nil {
+		return false
+	}
+	_, ok := s[u.Scheme]
+	return ok
+}
+
+// NewStorage returns the storage used by the operator, which supports savepoints on the local file system
+func NewStorage() Storages {
+	return Storages{
+		"":     FileSystemStorage{},
+		"file": FileSystemStorage{},
+	}
+}
+
+// FileSystemStorage deletes savepoints on a file system that is mounted into the operator, for locations of the
+// form file:///path or /path
+type FileSystemStorage struct{}
+
+func (FileSystemStorage) Delete(ctx context.Context, location string) error {
+	u, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("invalid savepoint location %s: %v", location, err)
+	}
+
+	path := filepath.Clean(u.Path)
+	if !filepath.IsAbs(path) || path == string(filepath.Separator) {
+		return fmt.Errorf("refusing to delete savepoint at %s", location)
+	}
+	return os.RemoveAll(path)
+}
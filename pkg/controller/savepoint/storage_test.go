@@ -0,0 +1,47 @@
+package savepoint
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSystemStorageDelete(t *testing.T) {
+	dir, err := ioutil.TempDir("", "savepoints")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	savepoint := filepath.Join(dir, "savepoint-abc123")
+	assert.Nil(t, os.Mkdir(savepoint, 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(savepoint, "_metadata"), []byte("metadata"), 0644))
+
+	storage := NewStorage()
+	// Flink reports local savepoints as file:/path
+	assert.Nil(t, storage.Delete(context.Background(), "file:"+savepoint))
+	_, err = os.Stat(savepoint)
+	assert.True(t, os.IsNotExist(err))
+
+	// deleting it again is not an error
+	assert.Nil(t, storage.Delete(context.Background(), "file://"+savepoint))
+	assert.Nil(t, storage.Delete(context.Background(), savepoint))
+}
+
+func TestStorageDeleteErrors(t *testing.T) {
+	storage := NewStorage()
+	err := storage.Delete(context.Background(), "s3://bucket/savepoints/savepoint-abc123")
+	assert.EqualError(t, err, "deleting savepoints with scheme 's3' is not supported")
+
+	assert.NotNil(t, storage.Delete(context.Background(), "file:///"))
+	assert.NotNil(t, storage.Delete(context.Background(), "savepoints/savepoint-abc123"))
+}
+
+func TestStorageSupports(t *testing.T) {
+	storage := NewStorage()
+	assert.True(t, storage.Supports("file:///checkpoints/flink/savepoints"))
+	assert.True(t, storage.Supports("/checkpoints/flink/savepoints"))
+	assert.False(t, storage.Supports("s3://bucket/savepoints"))
+}
@@ -0,0 +1,233 @@
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/client/clientset/versioned"
+	flinkv1beta1 "github.com/lyft/flinkk8soperator/pkg/client/clientset/versioned/typed/app/v1beta1"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+const defaultPollInterval = 2 * time.Second
+
+// Returns true once the application has reached the state an action waits for, or an error if it cannot be reached
+type Condition func(app *v1beta1.FlinkApplication) (bool, error)
+
+// Performs operational actions on FlinkApplications by patching the fields of their spec that the operator acts on
+type Client struct {
+	apps         flinkv1beta1.FlinkApplicationInterface
+	pollInterval time.Duration
+}
+
+func NewClient(clientset versioned.Interface, namespace string) *Client {
+	return &Client{
+		apps:         clientset.FlinkV1beta1().FlinkApplications(namespace),
+		pollInterval: defaultPollInterval,
+	}
+}
+
+func (c *Client) Get(name string) (*v1beta1.FlinkApplication, error) {
+	return c.apps.Get(name, metav1.GetOptions{})
+}
+
+func (c *Client) patchSpec(name string, spec map[string]interface{}) (*v1beta1.FlinkApplication, error) {
+	patch, err := json.Marshal(map[string]interface{}{"spec": spec})
+	if err != nil {
+		return nil, err
+	}
+	return c.apps.Patch(name, types.MergePatchType, patch)
+}
+
+// Triggers a savepoint of the running job by setting spec.savepointNonce
+func (c *Client) Savepoint(name string, nonce string) (Condition, error) {
+	app, err := c.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if app.Spec.SavepointNonce == nonce {
+		return nil, errors.Errorf("savepointNonce is already %q", nonce)
+	}
+
+	if _, err := c.patchSpec(name, map[string]interface{}{"savepointNonce": nonce}); err != nil {
+		return nil, err
+	}
+	return savepointCompleted(nonce), nil
+}
+
+func savepointCompleted(nonce string) Condition {
+	return func(app *v1beta1.FlinkApplication) (bool, error) {
+		manual := app.Status.ManualSavepoint
+		if manual == nil || manual.Nonce != nonce {
+			return false, nil
+		}
+		switch manual.State {
+		case v1beta1.SavepointSucceeded:
+			return true, nil
+		case v1beta1.SavepointFailed:
+			return false, errors.Errorf("savepoint failed: %s", manual.FailureCause)
+		}
+		return false, nil
+	}
+}
+
+// Rolls back the deploy in progress by setting spec.forceRollback, or clears it again
+func (c *Client) Rollback(name string, clear bool) (Condition, error) {
+	app, err := c.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if clear {
+		if _, err := c.patchSpec(name, map[string]interface{}{"forceRollback": false}); err != nil {
+			return nil, err
+		}
+		return func(*v1beta1.FlinkApplication) (bool, error) { return true, nil }, nil
+	}
+	if v1beta1.IsRunningPhase(app.Status.Phase) || app.Status.Phase == v1beta1.FlinkApplicationDualRunning {
+		return nil, errors.Errorf("application is in phase %s, and there is no deploy in progress to roll back",
+			app.Status.Phase)
+	}
+
+	if _, err := c.patchSpec(name, map[string]interface{}{"forceRollback": true}); err != nil {
+		return nil, err
+	}
+	return phaseReached(v1beta1.FlinkApplicationDeployFailed), nil
+}
+
+// Restarts the application on a new cluster by setting spec.restartNonce
+func (c *Client) Restart(name string, nonce string) (Condition, error) {
+	app, err := c.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if app.Spec.RestartNonce == nonce {
+		return nil, errors.Errorf("restartNonce is already %q", nonce)
+	}
+
+	if _, err := c.patchSpec(name, map[string]interface{}{"restartNonce": nonce}); err != nil {
+		return nil, err
+	}
+	return deployed(getCurrentHash(app)), nil
+}
+
+// Keeps the given version of a BlueGreen application running and tears down the other one
+func (c *Client) Promote(name string, version v1beta1.FlinkApplicationVersion) (Condition, error) {
+	app, err := c.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if app.Status.Phase != v1beta1.FlinkApplicationDualRunning {
+		return nil, errors.Errorf("application is in phase %s, but can only be promoted in phase %s",
+			app.Status.Phase, v1beta1.FlinkApplicationDualRunning)
+	}
+
+	found := false
+	teardownHash := ""
+	for _, status := range app.Status.VersionStatuses {
+		if status.Version == version {
+			found = true
+		} else if status.VersionHash != "" {
+			teardownHash = status.VersionHash
+		}
+	}
+	if !found {
+		return nil, errors.Errorf("application has no version %s", version)
+	}
+	if teardownHash == "" {
+		return nil, errors.New("application has no other version to tear down")
+	}
+	return c.teardown(name, teardownHash)
+}
+
+// Tears down the version of a BlueGreen application with the given hash
+func (c *Client) Teardown(name string, hash string) (Condition, error) {
+	app, err := c.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if !v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
+		return nil, errors.New("versions can only be torn down with the BlueGreen deployment mode")
+	}
+
+	for _, status := range app.Status.VersionStatuses {
+		if status.VersionHash == hash {
+			return c.teardown(name, hash)
+		}
+	}
+	return nil, errors.Errorf("application has no version with hash %s", hash)
+}
+
+func (c *Client) teardown(name string, hash string) (Condition, error) {
+	if _, err := c.patchSpec(name, map[string]interface{}{"tearDownVersionHash": hash}); err != nil {
+		return nil, err
+	}
+	return func(app *v1beta1.FlinkApplication) (bool, error) {
+		if app.Status.Phase != v1beta1.FlinkApplicationRunning {
+			return false, nil
+		}
+		for _, status := range app.Status.VersionStatuses {
+			if status.VersionHash == hash {
+				return false, nil
+			}
+		}
+		return true, nil
+	}, nil
+}
+
+// Returns the hash of the cluster that is being deployed, or of the deployed one if there is no deploy in progress
+func getCurrentHash(app *v1beta1.FlinkApplication) string {
+	if app.Status.Phase == v1beta1.FlinkApplicationDualRunning {
+		return app.Status.UpdatingHash
+	}
+	return app.Status.DeployHash
+}
+
+func phaseReached(phase v1beta1.FlinkApplicationPhase) Condition {
+	return func(app *v1beta1.FlinkApplication) (bool, error) {
+		return app.Status.Phase == phase, nil
+	}
+}
+
+// Waits for a cluster other than the one with the given hash to be running
+func deployed(oldHash string) Condition {
+	return func(app *v1beta1.FlinkApplication) (bool, error) {
+		switch app.Status.Phase {
+		case v1beta1.FlinkApplicationDeployFailed:
+			return false, errors.Errorf("deploy failed: %s", app.Status.Reason)
+		case v1beta1.FlinkApplicationRunning, v1beta1.FlinkApplicationDualRunning:
+			return getCurrentHash(app) != oldHash, nil
+		}
+		return false, nil
+	}
+}
+
+// Polls the application until the condition is met, returning the application in the state that met it
+func (c *Client) Wait(ctx context.Context, name string, condition Condition) (*v1beta1.FlinkApplication, error) {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		app, err := c.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		done, err := condition(app)
+		if err != nil {
+			return app, err
+		}
+		if done {
+			return app, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return app, fmt.Errorf("timed out waiting for application in phase %s", app.Status.Phase)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package ctl
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testNamespace = "flink"
+const testAppName = "test-app"
+
+func getTestApp(phase v1beta1.FlinkApplicationPhase) *v1beta1.FlinkApplication {
+	return &v1beta1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testAppName,
+			Namespace: testNamespace,
+		},
+		Spec: v1beta1.FlinkApplicationSpec{
+			RestartNonce: "1",
+		},
+		Status: v1beta1.FlinkApplicationStatus{
+			Phase:      phase,
+			DeployHash: "old-hash",
+		},
+	}
+}
+
+func getBlueGreenTestApp() *v1beta1.FlinkApplication {
+	app := getTestApp(v1beta1.FlinkApplicationDualRunning)
+	app.Spec.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	app.Status.DeploymentMode = v1beta1.DeploymentModeBlueGreen
+	app.Status.DeployVersion = v1beta1.BlueFlinkApplication
+	app.Status.UpdatingVersion = v1beta1.GreenFlinkApplication
+	app.Status.UpdatingHash = "new-hash"
+	app.Status.VersionStatuses = []v1beta1.FlinkApplicationVersionStatus{
+		{
+			Version:     v1beta1.BlueFlinkApplication,
+			VersionHash: "old-hash",
+			JobStatus:   v1beta1.FlinkJobStatus{JobID: "j1", State: v1beta1.Running, Health: v1beta1.Green},
+		},
+		{
+			Version:     v1beta1.GreenFlinkApplication,
+			VersionHash: "new-hash",
+			JobStatus:   v1beta1.FlinkJobStatus{JobID: "j2", State: v1beta1.Running, Health: v1beta1.Green},
+		},
+	}
+	return app
+}
+
+func getTestClient(app *v1beta1.FlinkApplication) (*Client, *fake.Clientset) {
+	clientset := fake.NewSimpleClientset(app)
+	client := NewClient(clientset, testNamespace)
+	client.pollInterval = time.Millisecond
+	return client, clientset
+}
+
+func updateStatus(t *testing.T, clientset *fake.Clientset, update func(app *v1beta1.FlinkApplication)) {
+	apps := clientset.FlinkV1beta1().FlinkApplications(testNamespace)
+	app, err := apps.Get(testAppName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	update(app)
+	_, err = apps.Update(app)
+	assert.Nil(t, err)
+}
+
+func TestSavepoint(t *testing.T) {
+	client, clientset := getTestClient(getTestApp(v1beta1.FlinkApplicationRunning))
+
+	condition, err := client.Savepoint(testAppName, "sp-1")
+	assert.Nil(t, err)
+	app, _ := client.Get(testAppName)
+	assert.Equal(t, "sp-1", app.Spec.SavepointNonce)
+
+	done, err := condition(app)
+	assert.False(t, done)
+	assert.Nil(t, err)
+
+	updateStatus(t, clientset, func(app *v1beta1.FlinkApplication) {
+		app.Status.ManualSavepoint = &v1beta1.ManualSavepoint{
+			Nonce:    "sp-1",
+			State:    v1beta1.SavepointSucceeded,
+			Location: "s3://savepoints/1",
+		}
+	})
+	app, err = client.Wait(context.Background(), testAppName, condition)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3://savepoints/1", app.Status.ManualSavepoint.Location)
+
+	// the same nonce would not trigger a new savepoint
+	_, err = client.Savepoint(testAppName, "sp-1")
+	assert.NotNil(t, err)
+}
+
+func TestRollback(t *testing.T) {
+	client, clientset := getTestClient(getTestApp(v1beta1.FlinkApplicationRunning))
+
+	_, err := client.Rollback(testAppName, false)
+	assert.NotNil(t, err)
+
+	updateStatus(t, clientset, func(app *v1beta1.FlinkApplication) {
+		app.Status.Phase = v1beta1.FlinkApplicationSubmittingJob
+	})
+	condition, err := client.Rollback(testAppName, false)
+	assert.Nil(t, err)
+	app, _ := client.Get(testAppName)
+	assert.True(t, app.Spec.ForceRollback)
+
+	updateStatus(t, clientset, func(app *v1beta1.FlinkApplication) {
+		app.Status.Phase = v1beta1.FlinkApplicationDeployFailed
+	})
+	_, err = client.Wait(context.Background(), testAppName, condition)
+	assert.Nil(t, err)
+
+	_, err = client.Rollback(testAppName, true)
+	assert.Nil(t, err)
+	app, _ = client.Get(testAppName)
+	assert.False(t, app.Spec.ForceRollback)
+}
+
+func TestRestart(t *testing.T) {
+	client, clientset := getTestClient(getTestApp(v1beta1.FlinkApplicationRunning))
+
+	condition, err := client.Restart(testAppName, "2")
+	assert.Nil(t, err)
+	app, _ := client.Get(testAppName)
+	assert.Equal(t, "2", app.Spec.RestartNonce)
+
+	// the old cluster is still running
+	done, _ := condition(app)
+	assert.False(t, done)
+
+	updateStatus(t, clientset, func(app *v1beta1.FlinkApplication) {
+		app.Status.Phase = v1beta1.FlinkApplicationDeployFailed
+		app.Status.Reason = "job failed to start"
+	})
+	_, err = client.Wait(context.Background(), testAppName, condition)
+	assert.EqualError(t, err, "deploy failed: job failed to start")
+
+	updateStatus(t, clientset, func(app *v1beta1.FlinkApplication) {
+		app.Status.Phase = v1beta1.FlinkApplicationRunning
+		app.Status.DeployHash = "new-hash"
+	})
+	_, err = client.Wait(context.Background(), testAppName, condition)
+	assert.Nil(t, err)
+}
+
+func TestPromote(t *testing.T) {
+	client, clientset := getTestClient(getBlueGreenTestApp())
+
+	_, err := client.Promote(testAppName, "red")
+	assert.NotNil(t, err)
+
+	condition, err := client.Promote(testAppName, v1beta1.GreenFlinkApplication)
+	assert.Nil(t, err)
+	app, _ := client.Get(testAppName)
+	assert.Equal(t, "old-hash", app.Spec.TearDownVersionHash)
+
+	done, _ := condition(app)
+	assert.False(t, done)
+
+	updateStatus(t, clientset, func(app *v1beta1.FlinkApplication) {
+		app.Status.Phase = v1beta1.FlinkApplicationRunning
+		app.Status.VersionStatuses[0] = v1beta1.FlinkApplicationVersionStatus{}
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = client.Wait(ctx, testAppName, condition)
+	assert.Nil(t, err)
+}
+
+func TestTeardown(t *testing.T) {
+	client, _ := getTestClient(getBlueGreenTestApp())
+
+	_, err := client.Teardown(testAppName, "unknown-hash")
+	assert.NotNil(t, err)
+
+	_, err = client.Teardown(testAppName, "new-hash")
+	assert.Nil(t, err)
+	app, _ := client.Get(testAppName)
+	assert.Equal(t, "new-hash", app.Spec.TearDownVersionHash)
+
+	client, _ = getTestClient(getTestApp(v1beta1.FlinkApplicationRunning))
+	_, err = client.Teardown(testAppName, "old-hash")
+	assert.NotNil(t, err)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	client, _ := getTestClient(getTestApp(v1beta1.FlinkApplicationSubmittingJob))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.Wait(ctx, testAppName, phaseReached(v1beta1.FlinkApplicationRunning))
+	assert.EqualError(t, err, "timed out waiting for application in phase SubmittingJob")
+}
+
+func TestPrintStatus(t *testing.T) {
+	var out bytes.Buffer
+	assert.Nil(t, PrintStatus(&out, getBlueGreenTestApp()))
+	assert.Equal(t, `Name:           flink/test-app
+Phase:          DualRunning
+Deploy hash:    old-hash
+Updating hash:  new-hash
+
+VERSION          HASH      CLUSTER  TASK MANAGERS  JOB ID  JOB STATE  JOB HEALTH
+blue (deployed)  old-hash           0/0            j1      RUNNING    Green
+green            new-hash           0/0            j2      RUNNING    Green
+`, out.String())
+}
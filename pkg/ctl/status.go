@@ -0,0 +1,66 @@
+package ctl
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+)
+
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
+
+// Writes a summary of the status of the application, with a row for each of its versions in the BlueGreen
+// deployment mode
+func PrintStatus(out io.Writer, app *v1beta1.FlinkApplication) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	_, _ = fmt.Fprintf(w, "Name:\t%s/%s\n", app.Namespace, app.Name)
+	_, _ = fmt.Fprintf(w, "Phase:\t%s\n", app.Status.Phase)
+	if app.Status.Reason != "" {
+		_, _ = fmt.Fprintf(w, "Reason:\t%s\n", app.Status.Reason)
+	}
+	_, _ = fmt.Fprintf(w, "Deploy hash:\t%s\n", orNone(app.Status.DeployHash))
+	if app.Status.UpdatingHash != "" {
+		_, _ = fmt.Fprintf(w, "Updating hash:\t%s\n", app.Status.UpdatingHash)
+	}
+	if app.Status.FailedDeployHash != "" {
+		_, _ = fmt.Fprintf(w, "Failed deploy hash:\t%s\n", app.Status.FailedDeployHash)
+	}
+	if manual := app.Status.ManualSavepoint; manual != nil {
+		_, _ = fmt.Fprintf(w, "Manual savepoint:\t%s %s %s\n", manual.Nonce, manual.State, manual.Location)
+	}
+	if lastErr := app.Status.LastSeenError; lastErr != nil {
+		_, _ = fmt.Fprintf(w, "Last error:\t%s\n", lastErr.Error())
+	}
+
+	if !v1beta1.IsBlueGreenDeploymentMode(app.Status.DeploymentMode) {
+		job := app.Status.JobStatus
+		_, _ = fmt.Fprintf(w, "Job:\t%s %s %s\n", orNone(job.JobID), job.State, job.Health)
+		_, _ = fmt.Fprintf(w, "Cluster:\t%s, %d/%d task managers\n", app.Status.ClusterStatus.Health,
+			app.Status.ClusterStatus.HealthyTaskManagers, app.Status.ClusterStatus.NumberOfTaskManagers)
+		return w.Flush()
+	}
+
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "VERSION\tHASH\tCLUSTER\tTASK MANAGERS\tJOB ID\tJOB STATE\tJOB HEALTH")
+	for _, status := range app.Status.VersionStatuses {
+		if status.VersionHash == "" {
+			continue
+		}
+		version := string(status.Version)
+		if status.Version == app.Status.DeployVersion {
+			version += " (deployed)"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%s\t%s\t%s\n",
+			version, status.VersionHash, status.ClusterStatus.Health,
+			status.ClusterStatus.HealthyTaskManagers, status.ClusterStatus.NumberOfTaskManagers,
+			orNone(status.JobStatus.JobID), status.JobStatus.State, status.JobStatus.Health)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,10 @@
+package webhook
+
+import (
+	"github.com/lyft/flinkk8soperator/pkg/webhook/flinkapplication"
+)
+
+func init() {
+	// AddToManagerFuncs is a list of functions to create webhooks and add them to a manager.
+	AddToManagerFuncs = append(AddToManagerFuncs, flinkapplication.Add)
+}
@@ -0,0 +1,39 @@
+package flinkapplication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const DefaultingWebhookPath = "/mutate-flink-k8s-io-v1beta1-flinkapplication"
+
+// DefaultingWebhook writes the defaults that the operator assumes for unset fields into FlinkApplications, so that
+// the stored resource reflects what is actually deployed
+type DefaultingWebhook struct {
+	decoder *admission.Decoder
+}
+
+func (d *DefaultingWebhook) InjectDecoder(decoder *admission.Decoder) error {
+	d.decoder = decoder
+	return nil
+}
+
+func (d *DefaultingWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	app := &v1beta1.FlinkApplication{}
+	if err := d.decoder.Decode(req, app); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	v1beta1.SetObjectDefaults_FlinkApplication(app)
+
+	marshaled, err := json.Marshal(app)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
@@ -0,0 +1,62 @@
+package flinkapplication
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta1"
+	"github.com/lyft/flinkk8soperator/pkg/controller/flink"
+	"github.com/lyft/flytestdlib/logger"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const ValidatingWebhookPath = "/validate-flink-k8s-io-v1beta1-flinkapplication"
+
+// ValidatingWebhook rejects FlinkApplications that the operator would not be able to deploy
+type ValidatingWebhook struct {
+	decoder *admission.Decoder
+}
+
+func (v *ValidatingWebhook) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+func (v *ValidatingWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
+	app := &v1beta1.FlinkApplication{}
+	if err := v.decoder.Decode(req, app); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Never block the removal of finalizers on an application that is being deleted
+	if !app.DeletionTimestamp.IsZero() {
+		return admission.Allowed("")
+	}
+
+	var errs field.ErrorList
+	if req.Operation == admissionv1beta1.Update {
+		old := &v1beta1.FlinkApplication{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		// Updates that do not touch the spec (e.g., finalizers or labels set by the operator) are always allowed,
+		// so that applications created before validation was enabled continue to be managed
+		if apiequality.Semantic.DeepEqual(app.Spec, old.Spec) {
+			return admission.Allowed("")
+		}
+		errs = flink.ValidateApplicationUpdate(app, old)
+	} else {
+		errs = flink.ValidateApplication(app)
+	}
+
+	if len(errs) > 0 {
+		logger.Infof(ctx, "Rejected FlinkApplication %s/%s: %v", req.Namespace, req.Name, errs.ToAggregate())
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,31 @@
+package flinkapplication
+
+import (
+	"context"
+
+	"github.com/lyft/flinkk8soperator/pkg/controller/config"
+	"github.com/lyft/flytestdlib/logger"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+// The conversion webhook serves all versions of the FlinkApplication resource that are registered with the scheme
+const ConversionWebhookPath = "/convert"
+
+// Add registers the FlinkApplication admission and conversion webhooks with the webhook server of the Manager. The server is
+// started along with the Manager.
+func Add(ctx context.Context, mgr manager.Manager, cfg config.RuntimeConfig) error {
+	server := mgr.GetWebhookServer()
+
+	logger.Infof(ctx, "Registering defaulting webhook at %s", DefaultingWebhookPath)
+	server.Register(DefaultingWebhookPath, &admission.Webhook{Handler: &DefaultingWebhook{}})
+
+	logger.Infof(ctx, "Registering validating webhook at %s", ValidatingWebhookPath)
+	server.Register(ValidatingWebhookPath, &admission.Webhook{Handler: &ValidatingWebhook{}})
+
+	logger.Infof(ctx, "Registering conversion webhook at %s", ConversionWebhookPath)
+	server.Register(ConversionWebhookPath, &conversion.Webhook{})
+
+	return nil
+}
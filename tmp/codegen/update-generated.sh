@@ -5,8 +5,14 @@ set -o nounset
 set -o pipefail
 
 vendor/k8s.io/code-generator/generate-groups.sh \
-deepcopy,client \
+deepcopy,client,defaulter \
 github.com/lyft/flinkk8soperator/pkg/client \
 github.com/lyft/flinkk8soperator/pkg/apis \
-app:v1beta1 \
+app:v1beta1,v1beta2 \
+--go-header-file "./tmp/codegen/boilerplate.go.txt"
+
+go install ./vendor/k8s.io/code-generator/cmd/conversion-gen
+"$(go env GOPATH)/bin/conversion-gen" \
+--input-dirs github.com/lyft/flinkk8soperator/pkg/apis/app/v1beta2 \
+--output-file-base zz_generated.conversion \
 --go-header-file "./tmp/codegen/boilerplate.go.txt"